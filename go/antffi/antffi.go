@@ -231,8 +231,12 @@ extern void* uniffi_ant_ffi_fn_constructor_networkpointer_new(void* key, uint64_
 extern void* uniffi_ant_ffi_fn_method_networkpointer_address(void* ptr, RustCallStatus* status);
 extern void* uniffi_ant_ffi_fn_method_networkpointer_target(void* ptr, RustCallStatus* status);
 extern uint64_t uniffi_ant_ffi_fn_method_networkpointer_counter(void* ptr, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_constructor_networkpointer_from_bytes(RustBuffer bytes, RustCallStatus* status);
+extern RustBuffer uniffi_ant_ffi_fn_method_networkpointer_to_bytes(void* ptr, RustCallStatus* status);
+extern RustBuffer uniffi_ant_ffi_fn_method_networkpointer_snapshot(void* ptr, RustCallStatus* status);
 extern void uniffi_ant_ffi_fn_free_networkpointer(void* ptr, RustCallStatus* status);
 extern void* uniffi_ant_ffi_fn_clone_networkpointer(void* ptr, RustCallStatus* status);
+extern void uniffi_ant_ffi_fn_func_pointer_verify(void* pointer, RustCallStatus* status);
 
 // ========== Scratchpad - ScratchpadAddress ==========
 
@@ -252,8 +256,12 @@ extern uint64_t uniffi_ant_ffi_fn_method_scratchpad_counter(void* ptr, RustCallS
 extern RustBuffer uniffi_ant_ffi_fn_method_scratchpad_decrypt_data(void* ptr, void* secretKey, RustCallStatus* status);
 extern void* uniffi_ant_ffi_fn_method_scratchpad_owner(void* ptr, RustCallStatus* status);
 extern RustBuffer uniffi_ant_ffi_fn_method_scratchpad_encrypted_data(void* ptr, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_constructor_scratchpad_from_bytes(RustBuffer bytes, RustCallStatus* status);
+extern RustBuffer uniffi_ant_ffi_fn_method_scratchpad_to_bytes(void* ptr, RustCallStatus* status);
+extern RustBuffer uniffi_ant_ffi_fn_method_scratchpad_snapshot(void* ptr, RustCallStatus* status);
 extern void uniffi_ant_ffi_fn_free_scratchpad(void* ptr, RustCallStatus* status);
 extern void* uniffi_ant_ffi_fn_clone_scratchpad(void* ptr, RustCallStatus* status);
+extern void uniffi_ant_ffi_fn_func_scratchpad_verify(void* scratchpad, RustCallStatus* status);
 
 // ========== Register - RegisterAddress ==========
 
@@ -284,6 +292,7 @@ extern void* uniffi_ant_ffi_fn_method_graphentry_address(void* ptr, RustCallStat
 extern RustBuffer uniffi_ant_ffi_fn_method_graphentry_content(void* ptr, RustCallStatus* status);
 extern RustBuffer uniffi_ant_ffi_fn_method_graphentry_parents(void* ptr, RustCallStatus* status);
 extern RustBuffer uniffi_ant_ffi_fn_method_graphentry_descendants(void* ptr, RustCallStatus* status);
+extern RustBuffer uniffi_ant_ffi_fn_method_graphentry_snapshot(void* ptr, RustCallStatus* status);
 extern void uniffi_ant_ffi_fn_free_graphentry(void* ptr, RustCallStatus* status);
 extern void* uniffi_ant_ffi_fn_clone_graphentry(void* ptr, RustCallStatus* status);
 
@@ -300,6 +309,12 @@ extern void* uniffi_ant_ffi_fn_clone_vaultsecretkey(void* ptr, RustCallStatus* s
 extern void* uniffi_ant_ffi_fn_constructor_userdata_new(RustCallStatus* status);
 extern RustBuffer uniffi_ant_ffi_fn_method_userdata_file_archives(void* ptr, RustCallStatus* status);
 extern RustBuffer uniffi_ant_ffi_fn_method_userdata_private_file_archives(void* ptr, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_method_userdata_add_file_archive(void* ptr, void* address, RustBuffer name, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_method_userdata_add_private_file_archive(void* ptr, void* dataMap, RustBuffer name, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_method_userdata_remove_file_archive(void* ptr, void* address, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_method_userdata_remove_private_file_archive(void* ptr, void* dataMap, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_method_userdata_rename_file_archive(void* ptr, void* address, RustBuffer name, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_method_userdata_rename_private_file_archive(void* ptr, void* dataMap, RustBuffer name, RustCallStatus* status);
 extern void uniffi_ant_ffi_fn_free_userdata(void* ptr, RustCallStatus* status);
 extern void* uniffi_ant_ffi_fn_clone_userdata(void* ptr, RustCallStatus* status);
 
@@ -322,6 +337,7 @@ extern void* uniffi_ant_ffi_fn_clone_wallet(void* ptr, RustCallStatus* status);
 
 extern uint64_t uniffi_ant_ffi_fn_constructor_client_init();
 extern uint64_t uniffi_ant_ffi_fn_constructor_client_init_local();
+extern uint64_t uniffi_ant_ffi_fn_constructor_client_init_with_peers(RustBuffer peers, void* evmNetwork, RustBuffer dataDir);
 extern void uniffi_ant_ffi_fn_free_client(void* ptr, RustCallStatus* status);
 extern void* uniffi_ant_ffi_fn_clone_client(void* ptr, RustCallStatus* status);
 
@@ -330,6 +346,7 @@ extern void* uniffi_ant_ffi_fn_clone_client(void* ptr, RustCallStatus* status);
 extern uint64_t uniffi_ant_ffi_fn_method_client_data_put_public(void* ptr, RustBuffer data, RustBuffer payment);
 extern uint64_t uniffi_ant_ffi_fn_method_client_data_get_public(void* ptr, RustBuffer addressHex);
 extern uint64_t uniffi_ant_ffi_fn_method_client_data_put(void* ptr, RustBuffer data, RustBuffer payment);
+extern uint64_t uniffi_ant_ffi_fn_method_client_data_put_with_progress(void* ptr, RustBuffer data, RustBuffer payment, uint64_t listener);
 extern uint64_t uniffi_ant_ffi_fn_method_client_data_get(void* ptr, void* dataMapChunk);
 extern uint64_t uniffi_ant_ffi_fn_method_client_data_cost(void* ptr, RustBuffer data);
 
@@ -339,6 +356,8 @@ extern uint64_t uniffi_ant_ffi_fn_method_client_file_upload_public(void* ptr, Ru
 extern uint64_t uniffi_ant_ffi_fn_method_client_file_download_public(void* ptr, void* address, RustBuffer destPath);
 extern uint64_t uniffi_ant_ffi_fn_method_client_file_upload(void* ptr, RustBuffer filePath, RustBuffer payment);
 extern uint64_t uniffi_ant_ffi_fn_method_client_file_download(void* ptr, void* dataMapChunk, RustBuffer destPath);
+extern uint64_t uniffi_ant_ffi_fn_method_client_file_upload_with_progress(void* ptr, RustBuffer filePath, RustBuffer payment, uint64_t listener);
+extern uint64_t uniffi_ant_ffi_fn_method_client_file_download_with_progress(void* ptr, void* dataMapChunk, RustBuffer destPath, uint64_t listener);
 extern uint64_t uniffi_ant_ffi_fn_method_client_file_cost(void* ptr, RustBuffer filePath);
 
 // ========== Client - Chunk Operations (Async) ==========
@@ -352,7 +371,8 @@ extern uint64_t uniffi_ant_ffi_fn_method_client_chunk_cost(void* ptr, void* addr
 extern uint64_t uniffi_ant_ffi_fn_method_client_pointer_get(void* ptr, void* address);
 extern uint64_t uniffi_ant_ffi_fn_method_client_pointer_put(void* ptr, void* pointer, RustBuffer payment);
 extern uint64_t uniffi_ant_ffi_fn_method_client_pointer_create(void* ptr, void* owner, void* target, RustBuffer payment);
-extern uint64_t uniffi_ant_ffi_fn_method_client_pointer_update(void* ptr, void* owner, void* target, RustBuffer payment);
+extern uint64_t uniffi_ant_ffi_fn_method_client_pointer_update(void* ptr, void* owner, void* target);
+extern uint64_t uniffi_ant_ffi_fn_method_client_pointer_update_from(void* ptr, void* current, void* owner, void* target);
 extern uint64_t uniffi_ant_ffi_fn_method_client_pointer_cost(void* ptr, void* key);
 extern uint64_t uniffi_ant_ffi_fn_method_client_pointer_check_existence(void* ptr, void* address);
 
@@ -367,7 +387,9 @@ extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_get(void* ptr, void*
 extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_put(void* ptr, void* scratchpad, RustBuffer payment);
 extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_get_from_public_key(void* ptr, void* publicKey);
 extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_create(void* ptr, void* owner, uint64_t contentType, RustBuffer initialData, RustBuffer payment);
-extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_update(void* ptr, void* owner, uint64_t contentType, RustBuffer data, RustBuffer payment);
+extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_update(void* ptr, void* owner, uint64_t contentType, RustBuffer data);
+extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_update_from(void* ptr, void* current, void* owner, uint64_t contentType, RustBuffer data);
+extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_put_update(void* ptr, void* scratchpad);
 extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_cost(void* ptr, void* publicKey);
 extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_check_existence(void* ptr, void* address);
 
@@ -377,18 +399,24 @@ extern uint64_t uniffi_ant_ffi_fn_method_client_register_get(void* ptr, void* ad
 extern uint64_t uniffi_ant_ffi_fn_method_client_register_create(void* ptr, void* owner, RustBuffer value, RustBuffer payment);
 extern uint64_t uniffi_ant_ffi_fn_method_client_register_update(void* ptr, void* owner, RustBuffer value, RustBuffer payment);
 extern uint64_t uniffi_ant_ffi_fn_method_client_register_cost(void* ptr, void* owner);
+extern void* uniffi_ant_ffi_fn_method_client_register_history(void* ptr, void* address, RustCallStatus* status);
+extern uint64_t uniffi_ant_ffi_fn_method_client_register_history_collect(void* ptr, void* address);
 
 // ========== Client - Vault Operations (Async) ==========
 
 extern uint64_t uniffi_ant_ffi_fn_method_client_vault_get_user_data(void* ptr, void* secretKey);
 extern uint64_t uniffi_ant_ffi_fn_method_client_vault_put_user_data(void* ptr, void* secretKey, RustBuffer payment, void* userData);
 extern uint64_t uniffi_ant_ffi_fn_method_client_vault_cost(void* ptr, void* key, uint64_t maxSize);
+extern uint64_t uniffi_ant_ffi_fn_method_client_vault_get(void* ptr, void* key);
+extern uint64_t uniffi_ant_ffi_fn_method_client_vault_put(void* ptr, RustBuffer data, RustBuffer payment, void* key, uint64_t contentType);
 
 // ========== Client - Archive Operations (Async) ==========
 
 extern uint64_t uniffi_ant_ffi_fn_method_client_archive_get_public(void* ptr, void* address);
 extern uint64_t uniffi_ant_ffi_fn_method_client_archive_put_public(void* ptr, void* archive, RustBuffer payment);
 extern uint64_t uniffi_ant_ffi_fn_method_client_archive_cost(void* ptr, void* archive);
+extern uint64_t uniffi_ant_ffi_fn_method_client_archive_get(void* ptr, void* dataMap);
+extern uint64_t uniffi_ant_ffi_fn_method_client_archive_put(void* ptr, void* archive, RustBuffer payment);
 
 // ========== Client - GraphEntry Operations (Async) - Additional ==========
 
@@ -397,10 +425,13 @@ extern uint64_t uniffi_ant_ffi_fn_method_client_graph_entry_check_existence(void
 
 // ========== Client - Directory Operations (Async) ==========
 
-extern uint64_t uniffi_ant_ffi_fn_method_client_dir_upload(void* ptr, RustBuffer path, RustBuffer payment);
-extern uint64_t uniffi_ant_ffi_fn_method_client_dir_upload_public(void* ptr, RustBuffer path, RustBuffer payment);
+extern uint64_t uniffi_ant_ffi_fn_method_client_dir_upload(void* ptr, RustBuffer path, void* wallet);
+extern uint64_t uniffi_ant_ffi_fn_method_client_dir_content_upload(void* ptr, RustBuffer path, RustBuffer payment);
+extern uint64_t uniffi_ant_ffi_fn_method_client_dir_upload_public(void* ptr, RustBuffer path, void* wallet);
 extern uint64_t uniffi_ant_ffi_fn_method_client_dir_download(void* ptr, void* dataMap, RustBuffer destPath);
 extern uint64_t uniffi_ant_ffi_fn_method_client_dir_download_public(void* ptr, void* address, RustBuffer destPath);
+extern uint64_t uniffi_ant_ffi_fn_method_client_dir_upload_with_progress(void* ptr, RustBuffer path, void* wallet, uint64_t listener);
+extern uint64_t uniffi_ant_ffi_fn_method_client_dir_download_with_progress(void* ptr, void* dataMap, RustBuffer destPath, uint64_t listener);
 
 // ========== Async Future Polling ==========
 
@@ -0,0 +1,44 @@
+// Package antffitest provides test helpers for code using the antffi bindings.
+package antffitest
+
+import (
+	"testing"
+
+	"github.com/maidsafe/ant-ffi/go/antffi"
+)
+
+// CheckLeaks enables handle tracking for the rest of the test and, when the
+// test finishes, fails it for every native handle created during the test
+// that has not been freed, and for every clone from CloneHandle that was not
+// passed to a consuming call. Each failure includes the allocation stack.
+//
+// Call it at the start of a test:
+//
+//	func TestSomething(t *testing.T) {
+//		antffitest.CheckLeaks(t)
+//		...
+//	}
+func CheckLeaks(t testing.TB) {
+	t.Helper()
+
+	wasEnabled := antffi.HandleTrackingEnabled()
+	antffi.EnableHandleTracking(true)
+
+	before := make(map[uint64]bool)
+	for _, h := range antffi.LiveHandles() {
+		before[h.ID] = true
+	}
+
+	t.Cleanup(func() {
+		for _, h := range antffi.LiveHandles() {
+			switch {
+			case before[h.ID]:
+			case h.Clone:
+				t.Errorf("leaked %s clone %#x, cloned at:\n%s", h.Type, h.Handle, h.Stack)
+			default:
+				t.Errorf("leaked %s handle %#x, allocated at:\n%s", h.Type, h.Handle, h.Stack)
+			}
+		}
+		antffi.EnableHandleTracking(wasEnabled)
+	})
+}
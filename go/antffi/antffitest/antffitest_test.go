@@ -0,0 +1,107 @@
+package antffitest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/maidsafe/ant-ffi/go/antffi"
+)
+
+// recorder captures the failures and cleanups CheckLeaks registers.
+type recorder struct {
+	testing.TB
+	errors   []string
+	cleanups []func()
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Cleanup(f func()) {
+	r.cleanups = append(r.cleanups, f)
+}
+
+func (r *recorder) finish() {
+	for i := len(r.cleanups) - 1; i >= 0; i-- {
+		r.cleanups[i]()
+	}
+}
+
+func TestCheckLeaksReportsLeak(t *testing.T) {
+	r := &recorder{TB: t}
+	CheckLeaks(r)
+
+	leaked, err := antffi.NewSecretKey()
+	if err != nil {
+		t.Fatalf("NewSecretKey failed: %v", err)
+	}
+	freed, err := antffi.NewSecretKey()
+	if err != nil {
+		t.Fatalf("NewSecretKey failed: %v", err)
+	}
+	freed.Free()
+
+	r.finish()
+	leaked.Free()
+
+	if len(r.errors) != 1 {
+		t.Fatalf("Expected 1 leak, got %d: %v", len(r.errors), r.errors)
+	}
+	if !strings.Contains(r.errors[0], "leaked SecretKey handle") || !strings.Contains(r.errors[0], "TestCheckLeaksReportsLeak") {
+		t.Fatalf("Leak report lacks type or allocation stack: %s", r.errors[0])
+	}
+	if antffi.HandleTrackingEnabled() {
+		t.Fatal("CheckLeaks did not restore the tracking mode")
+	}
+}
+
+func TestCheckLeaksIgnoresEarlierHandles(t *testing.T) {
+	antffi.EnableHandleTracking(true)
+	defer antffi.EnableHandleTracking(false)
+
+	earlier, err := antffi.NewSecretKey()
+	if err != nil {
+		t.Fatalf("NewSecretKey failed: %v", err)
+	}
+	defer earlier.Free()
+
+	r := &recorder{TB: t}
+	CheckLeaks(r)
+	r.finish()
+
+	if len(r.errors) != 0 {
+		t.Fatalf("Handles created before CheckLeaks were reported: %v", r.errors)
+	}
+}
+
+func TestCheckLeaksReportsUnconsumedClone(t *testing.T) {
+	key, err := antffi.NewSecretKey()
+	if err != nil {
+		t.Fatalf("NewSecretKey failed: %v", err)
+	}
+	defer key.Free()
+
+	r := &recorder{TB: t}
+	CheckLeaks(r)
+
+	// The clone is never passed to an FFI call, as on a faulty error path.
+	if key.CloneHandle() == nil {
+		t.Fatal("CloneHandle returned nil")
+	}
+	if _, err := key.ToHex(); err != nil {
+		t.Fatalf("ToHex failed: %v", err)
+	}
+
+	r.finish()
+
+	if len(r.errors) != 1 {
+		t.Fatalf("Expected 1 leak, got %d: %v", len(r.errors), r.errors)
+	}
+	if !strings.Contains(r.errors[0], "leaked SecretKey clone") || !strings.Contains(r.errors[0], "TestCheckLeaksReportsUnconsumedClone") {
+		t.Fatalf("Leak report lacks type or clone stack: %s", r.errors[0])
+	}
+}
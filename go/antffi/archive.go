@@ -54,6 +54,10 @@ extern uint64_t uniffi_ant_ffi_fn_method_privatearchive_file_count(void* ptr, Ru
 extern RustBuffer uniffi_ant_ffi_fn_method_privatearchive_data_maps(void* ptr, RustCallStatus* status);
 extern void uniffi_ant_ffi_fn_free_privatearchive(void* ptr, RustCallStatus* status);
 extern void* uniffi_ant_ffi_fn_clone_privatearchive(void* ptr, RustCallStatus* status);
+
+// Archive file handles, freed when AddFile is rejected after cloning them
+extern void uniffi_ant_ffi_fn_free_dataaddress(void* ptr, RustCallStatus* status);
+extern void uniffi_ant_ffi_fn_free_datamapchunk(void* ptr, RustCallStatus* status);
 */
 import "C"
 
@@ -75,7 +79,7 @@ func NewMetadata(size uint64) (*Metadata, error) {
 	var status C.RustCallStatus
 	handle := C.uniffi_ant_ffi_fn_constructor_metadata_new(C.uint64_t(size), &status)
 
-	if err := checkStatus(&status, "Metadata.New"); err != nil {
+	if err := checkStatus(&status, "Metadata.New", liftArchiveError); err != nil {
 		return nil, err
 	}
 
@@ -88,7 +92,7 @@ func NewMetadataWithTimestamps(size, created, modified uint64) (*Metadata, error
 	handle := C.uniffi_ant_ffi_fn_constructor_metadata_with_timestamps(
 		C.uint64_t(size), C.uint64_t(created), C.uint64_t(modified), &status)
 
-	if err := checkStatus(&status, "Metadata.WithTimestamps"); err != nil {
+	if err := checkStatus(&status, "Metadata.WithTimestamps", liftArchiveError); err != nil {
 		return nil, err
 	}
 
@@ -98,6 +102,7 @@ func NewMetadataWithTimestamps(size, created, modified uint64) (*Metadata, error
 func newMetadata(handle unsafe.Pointer) *Metadata {
 	m := &Metadata{handle: handle}
 	runtime.SetFinalizer(m, (*Metadata).Free)
+	trackHandle(unsafe.Pointer(m), "Metadata", m.handle)
 	return m
 }
 
@@ -111,9 +116,16 @@ func (m *Metadata) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_metadata(m.handle, &status)
+	untrackHandle(unsafe.Pointer(m))
 	m.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (m *Metadata) Close() error {
+	m.Free()
+	return nil
+}
+
 func (m *Metadata) Size() (uint64, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -124,9 +136,10 @@ func (m *Metadata) Size() (uint64, error) {
 
 	cloned := m.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_metadata_size(cloned, &status)
 
-	if err := checkStatus(&status, "Metadata.Size"); err != nil {
+	if err := checkStatus(&status, "Metadata.Size", liftArchiveError); err != nil {
 		return 0, err
 	}
 
@@ -143,9 +156,10 @@ func (m *Metadata) Created() (uint64, error) {
 
 	cloned := m.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_metadata_created(cloned, &status)
 
-	if err := checkStatus(&status, "Metadata.Created"); err != nil {
+	if err := checkStatus(&status, "Metadata.Created", liftArchiveError); err != nil {
 		return 0, err
 	}
 
@@ -162,9 +176,10 @@ func (m *Metadata) Modified() (uint64, error) {
 
 	cloned := m.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_metadata_modified(cloned, &status)
 
-	if err := checkStatus(&status, "Metadata.Modified"); err != nil {
+	if err := checkStatus(&status, "Metadata.Modified", liftArchiveError); err != nil {
 		return 0, err
 	}
 
@@ -173,7 +188,7 @@ func (m *Metadata) Modified() (uint64, error) {
 
 func (m *Metadata) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_metadata(m.handle, &status)
+	return trackClone("Metadata", C.uniffi_ant_ffi_fn_clone_metadata(m.handle, &status))
 }
 
 func (m *Metadata) CloneHandle() unsafe.Pointer {
@@ -199,7 +214,7 @@ func ArchiveAddressFromHex(hex string) (*ArchiveAddress, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_archiveaddress_from_hex(hexBuffer, &status)
 
-	if err := checkStatus(&status, "ArchiveAddress.FromHex"); err != nil {
+	if err := checkStatus(&status, "ArchiveAddress.FromHex", liftArchiveError); err != nil {
 		return nil, err
 	}
 
@@ -209,6 +224,7 @@ func ArchiveAddressFromHex(hex string) (*ArchiveAddress, error) {
 func newArchiveAddress(handle unsafe.Pointer) *ArchiveAddress {
 	aa := &ArchiveAddress{handle: handle}
 	runtime.SetFinalizer(aa, (*ArchiveAddress).Free)
+	trackHandle(unsafe.Pointer(aa), "ArchiveAddress", aa.handle)
 	return aa
 }
 
@@ -222,9 +238,16 @@ func (aa *ArchiveAddress) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_archiveaddress(aa.handle, &status)
+	untrackHandle(unsafe.Pointer(aa))
 	aa.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (aa *ArchiveAddress) Close() error {
+	aa.Free()
+	return nil
+}
+
 func (aa *ArchiveAddress) ToHex() (string, error) {
 	aa.mu.Lock()
 	defer aa.mu.Unlock()
@@ -235,9 +258,10 @@ func (aa *ArchiveAddress) ToHex() (string, error) {
 
 	cloned := aa.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_archiveaddress_to_hex(cloned, &status)
 
-	if err := checkStatus(&status, "ArchiveAddress.ToHex"); err != nil {
+	if err := checkStatus(&status, "ArchiveAddress.ToHex", liftArchiveError); err != nil {
 		return "", err
 	}
 
@@ -246,7 +270,7 @@ func (aa *ArchiveAddress) ToHex() (string, error) {
 
 func (aa *ArchiveAddress) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_archiveaddress(aa.handle, &status)
+	return trackClone("ArchiveAddress", C.uniffi_ant_ffi_fn_clone_archiveaddress(aa.handle, &status))
 }
 
 func (aa *ArchiveAddress) CloneHandle() unsafe.Pointer {
@@ -272,7 +296,7 @@ func PrivateArchiveDataMapFromHex(hex string) (*PrivateArchiveDataMap, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_privatearchivedatamap_from_hex(hexBuffer, &status)
 
-	if err := checkStatus(&status, "PrivateArchiveDataMap.FromHex"); err != nil {
+	if err := checkStatus(&status, "PrivateArchiveDataMap.FromHex", liftArchiveError); err != nil {
 		return nil, err
 	}
 
@@ -282,6 +306,7 @@ func PrivateArchiveDataMapFromHex(hex string) (*PrivateArchiveDataMap, error) {
 func newPrivateArchiveDataMap(handle unsafe.Pointer) *PrivateArchiveDataMap {
 	padm := &PrivateArchiveDataMap{handle: handle}
 	runtime.SetFinalizer(padm, (*PrivateArchiveDataMap).Free)
+	trackHandle(unsafe.Pointer(padm), "PrivateArchiveDataMap", padm.handle)
 	return padm
 }
 
@@ -295,9 +320,16 @@ func (padm *PrivateArchiveDataMap) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_privatearchivedatamap(padm.handle, &status)
+	untrackHandle(unsafe.Pointer(padm))
 	padm.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (padm *PrivateArchiveDataMap) Close() error {
+	padm.Free()
+	return nil
+}
+
 func (padm *PrivateArchiveDataMap) ToHex() (string, error) {
 	padm.mu.Lock()
 	defer padm.mu.Unlock()
@@ -308,9 +340,10 @@ func (padm *PrivateArchiveDataMap) ToHex() (string, error) {
 
 	cloned := padm.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_privatearchivedatamap_to_hex(cloned, &status)
 
-	if err := checkStatus(&status, "PrivateArchiveDataMap.ToHex"); err != nil {
+	if err := checkStatus(&status, "PrivateArchiveDataMap.ToHex", liftArchiveError); err != nil {
 		return "", err
 	}
 
@@ -319,7 +352,7 @@ func (padm *PrivateArchiveDataMap) ToHex() (string, error) {
 
 func (padm *PrivateArchiveDataMap) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_privatearchivedatamap(padm.handle, &status)
+	return trackClone("PrivateArchiveDataMap", C.uniffi_ant_ffi_fn_clone_privatearchivedatamap(padm.handle, &status))
 }
 
 func (padm *PrivateArchiveDataMap) CloneHandle() unsafe.Pointer {
@@ -331,6 +364,78 @@ func (padm *PrivateArchiveDataMap) CloneHandle() unsafe.Pointer {
 	return padm.cloneHandle()
 }
 
+// toDataMapChunk converts the private archive data map to the DataMapChunk
+// expected by the private archive client methods. Both share the same hex encoding.
+func (padm *PrivateArchiveDataMap) toDataMapChunk() (*DataMapChunk, error) {
+	hex, err := padm.ToHex()
+	if err != nil {
+		return nil, err
+	}
+	return DataMapChunkFromHex(hex)
+}
+
+// privateArchiveDataMapFromDataMapChunk converts a DataMapChunk returned by the
+// private archive client methods into a PrivateArchiveDataMap.
+func privateArchiveDataMapFromDataMapChunk(dmc *DataMapChunk) (*PrivateArchiveDataMap, error) {
+	hex, err := dmc.ToHex()
+	if err != nil {
+		return nil, err
+	}
+	return PrivateArchiveDataMapFromHex(hex)
+}
+
+// PublicArchiveFileEntry describes a file in a public archive.
+type PublicArchiveFileEntry struct {
+	// The file path within the archive
+	Path string
+	// The address of the file data
+	Address *DataAddress
+	// The file metadata
+	Metadata *Metadata
+}
+
+// Free releases the handles held by the entry.
+func (e *PublicArchiveFileEntry) Free() {
+	if e.Address != nil {
+		e.Address.Free()
+	}
+	if e.Metadata != nil {
+		e.Metadata.Free()
+	}
+}
+
+// Close implements io.Closer by calling Free. It always returns nil.
+func (e *PublicArchiveFileEntry) Close() error {
+	e.Free()
+	return nil
+}
+
+// PrivateArchiveFileEntry describes a file in a private archive.
+type PrivateArchiveFileEntry struct {
+	// The file path within the archive
+	Path string
+	// The data map to retrieve the file data
+	DataMap *DataMapChunk
+	// The file metadata
+	Metadata *Metadata
+}
+
+// Free releases the handles held by the entry.
+func (e *PrivateArchiveFileEntry) Free() {
+	if e.DataMap != nil {
+		e.DataMap.Free()
+	}
+	if e.Metadata != nil {
+		e.Metadata.Free()
+	}
+}
+
+// Close implements io.Closer by calling Free. It always returns nil.
+func (e *PrivateArchiveFileEntry) Close() error {
+	e.Free()
+	return nil
+}
+
 // PublicArchive represents a public archive.
 type PublicArchive struct {
 	handle unsafe.Pointer
@@ -343,7 +448,7 @@ func NewPublicArchive() (*PublicArchive, error) {
 	var status C.RustCallStatus
 	handle := C.uniffi_ant_ffi_fn_constructor_publicarchive_new(&status)
 
-	if err := checkStatus(&status, "PublicArchive.New"); err != nil {
+	if err := checkStatus(&status, "PublicArchive.New", liftArchiveError); err != nil {
 		return nil, err
 	}
 
@@ -353,6 +458,7 @@ func NewPublicArchive() (*PublicArchive, error) {
 func newPublicArchive(handle unsafe.Pointer) *PublicArchive {
 	pa := &PublicArchive{handle: handle}
 	runtime.SetFinalizer(pa, (*PublicArchive).Free)
+	trackHandle(unsafe.Pointer(pa), "PublicArchive", pa.handle)
 	return pa
 }
 
@@ -366,26 +472,48 @@ func (pa *PublicArchive) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_publicarchive(pa.handle, &status)
+	untrackHandle(unsafe.Pointer(pa))
 	pa.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (pa *PublicArchive) Close() error {
+	pa.Free()
+	return nil
+}
+
 // AddFile adds a file to the archive and returns a new archive with the file added.
 func (pa *PublicArchive) AddFile(path string, address *DataAddress, metadata *Metadata) (*PublicArchive, error) {
 	pa.mu.Lock()
 	defer pa.mu.Unlock()
 
+	if address == nil || metadata == nil {
+		return nil, ErrNilPointer
+	}
 	if pa.freed {
 		return nil, ErrDisposed
 	}
 
-	pathBuffer := stringToRustBuffer(path)
 	clonedAddr := address.CloneHandle()
+	if clonedAddr == nil {
+		return nil, ErrDisposed
+	}
 	clonedMeta := metadata.CloneHandle()
+	if clonedMeta == nil {
+		var status C.RustCallStatus
+		consumeClones(clonedAddr)
+		C.uniffi_ant_ffi_fn_free_dataaddress(clonedAddr, &status)
+		return nil, ErrDisposed
+	}
+
+	pathBuffer := stringToRustBuffer(path)
 
+	cloned := pa.cloneHandle()
 	var status C.RustCallStatus
-	handle := C.uniffi_ant_ffi_fn_method_publicarchive_add_file(pa.handle, pathBuffer, clonedAddr, clonedMeta, &status)
+	consumeClones(cloned, clonedAddr, clonedMeta)
+	handle := C.uniffi_ant_ffi_fn_method_publicarchive_add_file(cloned, pathBuffer, clonedAddr, clonedMeta, &status)
 
-	if err := checkStatus(&status, "PublicArchive.AddFile"); err != nil {
+	if err := checkStatus(&status, "PublicArchive.AddFile", liftArchiveError); err != nil {
 		return nil, err
 	}
 
@@ -404,16 +532,54 @@ func (pa *PublicArchive) RenameFile(oldPath, newPath string) (*PublicArchive, er
 	oldPathBuffer := stringToRustBuffer(oldPath)
 	newPathBuffer := stringToRustBuffer(newPath)
 
+	cloned := pa.cloneHandle()
 	var status C.RustCallStatus
-	handle := C.uniffi_ant_ffi_fn_method_publicarchive_rename_file(pa.handle, oldPathBuffer, newPathBuffer, &status)
+	consumeClones(cloned)
+	handle := C.uniffi_ant_ffi_fn_method_publicarchive_rename_file(cloned, oldPathBuffer, newPathBuffer, &status)
 
-	if err := checkStatus(&status, "PublicArchive.RenameFile"); err != nil {
+	if err := checkStatus(&status, "PublicArchive.RenameFile", liftArchiveError); err != nil {
 		return nil, err
 	}
 
 	return newPublicArchive(handle), nil
 }
 
+// Files returns the entries of the archive.
+// The returned handles are owned by the caller.
+func (pa *PublicArchive) Files() ([]PublicArchiveFileEntry, error) {
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+
+	if pa.freed {
+		return nil, ErrDisposed
+	}
+
+	cloned := pa.cloneHandle()
+	var status C.RustCallStatus
+	consumeClones(cloned)
+	result := C.uniffi_ant_ffi_fn_method_publicarchive_files(cloned, &status)
+
+	if err := checkStatus(&status, "PublicArchive.Files", liftArchiveError); err != nil {
+		return nil, err
+	}
+
+	return decodePublicArchiveFiles(fromRustBufferRaw(result, true))
+}
+
+// decodePublicArchiveFiles decodes a Vec<PublicArchiveFileEntry>
+// (path: String, address: Arc<DataAddress>, metadata: Arc<Metadata>).
+func decodePublicArchiveFiles(data []byte) ([]PublicArchiveFileEntry, error) {
+	paths, addresses, metadata, err := decodeArchiveFiles(data, "Vec<PublicArchiveFileEntry>", newDataAddress, newMetadata)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]PublicArchiveFileEntry, len(paths))
+	for i, path := range paths {
+		entries[i] = PublicArchiveFileEntry{Path: path, Address: addresses[i], Metadata: metadata[i]}
+	}
+	return entries, nil
+}
+
 func (pa *PublicArchive) FileCount() (uint64, error) {
 	pa.mu.Lock()
 	defer pa.mu.Unlock()
@@ -424,9 +590,10 @@ func (pa *PublicArchive) FileCount() (uint64, error) {
 
 	cloned := pa.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_publicarchive_file_count(cloned, &status)
 
-	if err := checkStatus(&status, "PublicArchive.FileCount"); err != nil {
+	if err := checkStatus(&status, "PublicArchive.FileCount", liftArchiveError); err != nil {
 		return 0, err
 	}
 
@@ -435,7 +602,7 @@ func (pa *PublicArchive) FileCount() (uint64, error) {
 
 func (pa *PublicArchive) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_publicarchive(pa.handle, &status)
+	return trackClone("PublicArchive", C.uniffi_ant_ffi_fn_clone_publicarchive(pa.handle, &status))
 }
 
 func (pa *PublicArchive) CloneHandle() unsafe.Pointer {
@@ -459,7 +626,7 @@ func NewPrivateArchive() (*PrivateArchive, error) {
 	var status C.RustCallStatus
 	handle := C.uniffi_ant_ffi_fn_constructor_privatearchive_new(&status)
 
-	if err := checkStatus(&status, "PrivateArchive.New"); err != nil {
+	if err := checkStatus(&status, "PrivateArchive.New", liftArchiveError); err != nil {
 		return nil, err
 	}
 
@@ -469,6 +636,7 @@ func NewPrivateArchive() (*PrivateArchive, error) {
 func newPrivateArchive(handle unsafe.Pointer) *PrivateArchive {
 	pa := &PrivateArchive{handle: handle}
 	runtime.SetFinalizer(pa, (*PrivateArchive).Free)
+	trackHandle(unsafe.Pointer(pa), "PrivateArchive", pa.handle)
 	return pa
 }
 
@@ -482,26 +650,48 @@ func (pa *PrivateArchive) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_privatearchive(pa.handle, &status)
+	untrackHandle(unsafe.Pointer(pa))
 	pa.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (pa *PrivateArchive) Close() error {
+	pa.Free()
+	return nil
+}
+
 // AddFile adds a file to the private archive.
 func (pa *PrivateArchive) AddFile(path string, dataMap *DataMapChunk, metadata *Metadata) (*PrivateArchive, error) {
 	pa.mu.Lock()
 	defer pa.mu.Unlock()
 
+	if dataMap == nil || metadata == nil {
+		return nil, ErrNilPointer
+	}
 	if pa.freed {
 		return nil, ErrDisposed
 	}
 
-	pathBuffer := stringToRustBuffer(path)
 	clonedDataMap := dataMap.CloneHandle()
+	if clonedDataMap == nil {
+		return nil, ErrDisposed
+	}
 	clonedMeta := metadata.CloneHandle()
+	if clonedMeta == nil {
+		var status C.RustCallStatus
+		consumeClones(clonedDataMap)
+		C.uniffi_ant_ffi_fn_free_datamapchunk(clonedDataMap, &status)
+		return nil, ErrDisposed
+	}
+
+	pathBuffer := stringToRustBuffer(path)
 
+	cloned := pa.cloneHandle()
 	var status C.RustCallStatus
-	handle := C.uniffi_ant_ffi_fn_method_privatearchive_add_file(pa.handle, pathBuffer, clonedDataMap, clonedMeta, &status)
+	consumeClones(cloned, clonedDataMap, clonedMeta)
+	handle := C.uniffi_ant_ffi_fn_method_privatearchive_add_file(cloned, pathBuffer, clonedDataMap, clonedMeta, &status)
 
-	if err := checkStatus(&status, "PrivateArchive.AddFile"); err != nil {
+	if err := checkStatus(&status, "PrivateArchive.AddFile", liftArchiveError); err != nil {
 		return nil, err
 	}
 
@@ -520,16 +710,95 @@ func (pa *PrivateArchive) RenameFile(oldPath, newPath string) (*PrivateArchive,
 	oldPathBuffer := stringToRustBuffer(oldPath)
 	newPathBuffer := stringToRustBuffer(newPath)
 
+	cloned := pa.cloneHandle()
 	var status C.RustCallStatus
-	handle := C.uniffi_ant_ffi_fn_method_privatearchive_rename_file(pa.handle, oldPathBuffer, newPathBuffer, &status)
+	consumeClones(cloned)
+	handle := C.uniffi_ant_ffi_fn_method_privatearchive_rename_file(cloned, oldPathBuffer, newPathBuffer, &status)
 
-	if err := checkStatus(&status, "PrivateArchive.RenameFile"); err != nil {
+	if err := checkStatus(&status, "PrivateArchive.RenameFile", liftArchiveError); err != nil {
 		return nil, err
 	}
 
 	return newPrivateArchive(handle), nil
 }
 
+// Files returns the entries of the private archive.
+// The returned handles are owned by the caller.
+func (pa *PrivateArchive) Files() ([]PrivateArchiveFileEntry, error) {
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+
+	if pa.freed {
+		return nil, ErrDisposed
+	}
+
+	cloned := pa.cloneHandle()
+	var status C.RustCallStatus
+	consumeClones(cloned)
+	result := C.uniffi_ant_ffi_fn_method_privatearchive_files(cloned, &status)
+
+	if err := checkStatus(&status, "PrivateArchive.Files", liftArchiveError); err != nil {
+		return nil, err
+	}
+
+	return decodePrivateArchiveFiles(fromRustBufferRaw(result, true))
+}
+
+// decodePrivateArchiveFiles decodes a Vec<PrivateArchiveFileEntry>
+// (path: String, data_map: Arc<DataMapChunk>, metadata: Arc<Metadata>).
+func decodePrivateArchiveFiles(data []byte) ([]PrivateArchiveFileEntry, error) {
+	paths, dataMaps, metadata, err := decodeArchiveFiles(data, "Vec<PrivateArchiveFileEntry>", newDataMapChunk, newMetadata)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]PrivateArchiveFileEntry, len(paths))
+	for i, path := range paths {
+		entries[i] = PrivateArchiveFileEntry{Path: path, DataMap: dataMaps[i], Metadata: metadata[i]}
+	}
+	return entries, nil
+}
+
+// decodeArchiveFiles decodes a sequence of archive file entries, each a path
+// followed by a data handle and a metadata handle, lifted by liftData and
+// liftMetadata. No handle is lifted until every entry has been read, so a
+// truncated record never yields wrappers around bytes that are not handles.
+// If the record has trailing bytes the lifted handles are freed again.
+func decodeArchiveFiles[D, M interface{ Free() }](data []byte, record string, liftData func(unsafe.Pointer) D, liftMetadata func(unsafe.Pointer) M) ([]string, []D, []M, error) {
+	reader := NewUniFFIReader(data)
+	count, ok := reader.readCount()
+	if !ok {
+		return nil, nil, nil, malformedRecord(record, len(data))
+	}
+
+	paths := make([]string, 0, count)
+	handles := make([]unsafe.Pointer, 0, 2*count)
+	for i := 0; i < count; i++ {
+		path, ok := reader.readString()
+		if !ok || reader.remaining() < 16 {
+			return nil, nil, nil, malformedRecord(record, len(data))
+		}
+		paths = append(paths, path)
+		handles = append(handles, reader.ReadPointer(), reader.ReadPointer())
+	}
+
+	dataHandles := make([]D, count)
+	metadata := make([]M, count)
+	for i := range paths {
+		dataHandles[i] = liftData(handles[2*i])
+		metadata[i] = liftMetadata(handles[2*i+1])
+	}
+
+	if reader.remaining() != 0 {
+		for i := range paths {
+			dataHandles[i].Free()
+			metadata[i].Free()
+		}
+		return nil, nil, nil, malformedRecord(record, len(data))
+	}
+
+	return paths, dataHandles, metadata, nil
+}
+
 func (pa *PrivateArchive) FileCount() (uint64, error) {
 	pa.mu.Lock()
 	defer pa.mu.Unlock()
@@ -540,9 +809,10 @@ func (pa *PrivateArchive) FileCount() (uint64, error) {
 
 	cloned := pa.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_privatearchive_file_count(cloned, &status)
 
-	if err := checkStatus(&status, "PrivateArchive.FileCount"); err != nil {
+	if err := checkStatus(&status, "PrivateArchive.FileCount", liftArchiveError); err != nil {
 		return 0, err
 	}
 
@@ -551,7 +821,7 @@ func (pa *PrivateArchive) FileCount() (uint64, error) {
 
 func (pa *PrivateArchive) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_privatearchive(pa.handle, &status)
+	return trackClone("PrivateArchive", C.uniffi_ant_ffi_fn_clone_privatearchive(pa.handle, &status))
 }
 
 func (pa *PrivateArchive) CloneHandle() unsafe.Pointer {
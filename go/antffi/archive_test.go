@@ -0,0 +1,226 @@
+package antffi
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"unsafe"
+)
+
+func TestPrivateArchiveDataMapConversionDisposed(t *testing.T) {
+	if _, err := (&PrivateArchiveDataMap{freed: true}).toDataMapChunk(); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed, got %v", err)
+	}
+	if _, err := privateArchiveDataMapFromDataMapChunk(&DataMapChunk{freed: true}); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed, got %v", err)
+	}
+}
+
+func TestPrivateArchiveClientArguments(t *testing.T) {
+	ctx := context.Background()
+	c := &Client{}
+
+	if _, err := c.ArchiveGet(ctx, nil); !errors.Is(err, ErrNilPointer) {
+		t.Fatalf("ArchiveGet: expected ErrNilPointer, got %v", err)
+	}
+	if _, err := c.ArchivePut(ctx, nil, nil); !errors.Is(err, ErrNilPointer) {
+		t.Fatalf("ArchivePut: expected ErrNilPointer, got %v", err)
+	}
+
+	// A closed client is reported before any argument is used.
+	if _, err := c.ArchiveGet(ctx, &PrivateArchiveDataMap{}); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("ArchiveGet: expected ErrDisposed, got %v", err)
+	}
+	if _, err := c.ArchivePut(ctx, &PrivateArchive{}, nil); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("ArchivePut: expected ErrDisposed, got %v", err)
+	}
+}
+
+// dirContentUploadArchive decodes a DirContentUploadResult carrying a clone of
+// archive's handle, as DirContentUpload would receive it from Rust.
+func dirContentUploadArchive(t *testing.T, archive *PrivateArchive) *DirContentUploadResult {
+	t.Helper()
+
+	handle := archive.CloneHandle()
+	if handle == nil {
+		t.Fatal("CloneHandle returned nil")
+	}
+	result, err := decodeDirContentUploadResult(buildCostAndHandle("252", uint64(uintptr(handle))))
+	if err != nil {
+		t.Fatalf("decodeDirContentUploadResult failed: %v", err)
+	}
+	return result
+}
+
+func TestDirContentUploadArchiveArguments(t *testing.T) {
+	archive, err := NewPrivateArchive()
+	if err != nil {
+		t.Fatalf("NewPrivateArchive failed: %v", err)
+	}
+	defer archive.Free()
+	result := dirContentUploadArchive(t, archive)
+	defer result.Archive.Free()
+
+	dataMap, err := DataMapChunkFromHex("deadbeef")
+	if err != nil {
+		t.Fatalf("DataMapChunkFromHex failed: %v", err)
+	}
+	defer dataMap.Free()
+	meta, err := NewMetadata(4)
+	if err != nil {
+		t.Fatalf("NewMetadata failed: %v", err)
+	}
+	defer meta.Free()
+
+	if _, err := result.Archive.AddFile("a.txt", nil, meta); !errors.Is(err, ErrNilPointer) {
+		t.Fatalf("AddFile with nil data map: expected ErrNilPointer, got %v", err)
+	}
+	if _, err := result.Archive.AddFile("a.txt", dataMap, nil); !errors.Is(err, ErrNilPointer) {
+		t.Fatalf("AddFile with nil metadata: expected ErrNilPointer, got %v", err)
+	}
+	if _, err := result.Archive.AddFile("a.txt", &DataMapChunk{freed: true}, meta); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("AddFile with freed data map: expected ErrDisposed, got %v", err)
+	}
+	if _, err := result.Archive.AddFile("a.txt", dataMap, &Metadata{freed: true}); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("AddFile with freed metadata: expected ErrDisposed, got %v", err)
+	}
+
+	result.Archive.Free()
+	if _, err := result.Archive.Files(); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Files after Free: expected ErrDisposed, got %v", err)
+	}
+	if _, err := result.Archive.AddFile("a.txt", dataMap, meta); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("AddFile after Free: expected ErrDisposed, got %v", err)
+	}
+}
+
+func TestDirContentUploadClosedClient(t *testing.T) {
+	c := &Client{}
+	c.Close()
+
+	if _, err := c.DirContentUpload(context.Background(), t.TempDir(), nil); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed, got %v", err)
+	}
+}
+
+// archiveFile is an entry of a hand-built archive file listing.
+type archiveFile struct {
+	path     string
+	data     uint64
+	metadata uint64
+}
+
+// buildArchiveFiles hand-builds the UniFFI encoding of a Vec of archive file entries.
+func buildArchiveFiles(files ...archiveFile) []byte {
+	buf := binary.BigEndian.AppendUint32(nil, uint32(len(files)))
+	for _, f := range files {
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(f.path)))
+		buf = append(buf, f.path...)
+		buf = binary.BigEndian.AppendUint64(buf, f.data)
+		buf = binary.BigEndian.AppendUint64(buf, f.metadata)
+	}
+	return buf
+}
+
+func TestDecodeArchiveFiles(t *testing.T) {
+	lift := func(handle unsafe.Pointer) *fakeObject { return &fakeObject{handle: handle} }
+	data := buildArchiveFiles(archiveFile{"a.txt", 0x1000, 0x1001}, archiveFile{"nested/b.txt", 0x2000, 0x2001})
+
+	paths, dataHandles, metadata, err := decodeArchiveFiles(data, "Vec<PublicArchiveFileEntry>", lift, lift)
+	if err != nil {
+		t.Fatalf("decodeArchiveFiles failed: %v", err)
+	}
+	if len(paths) != 2 || paths[0] != "a.txt" || paths[1] != "nested/b.txt" {
+		t.Fatalf("Decoded paths %q", paths)
+	}
+	if uintptr(dataHandles[1].handle) != 0x2000 || uintptr(metadata[1].handle) != 0x2001 {
+		t.Fatalf("Decoded handles %p and %p", dataHandles[1].handle, metadata[1].handle)
+	}
+
+	paths, _, _, err = decodeArchiveFiles(buildArchiveFiles(), "Vec<PublicArchiveFileEntry>", lift, lift)
+	if err != nil || len(paths) != 0 {
+		t.Fatalf("Empty listing decoded as %q, %v", paths, err)
+	}
+}
+
+func TestDecodeArchiveFilesMalformed(t *testing.T) {
+	valid := buildArchiveFiles(archiveFile{"a.txt", 0x1000, 0x1001})
+
+	cases := map[string][]byte{
+		"empty":            nil,
+		"negative count":   binary.BigEndian.AppendUint32(nil, 0xffffffff),
+		"count too large":  append(binary.BigEndian.AppendUint32(nil, 1<<30), valid[4:]...),
+		"truncated path":   valid[:8],
+		"overlong path":    append(append(binary.BigEndian.AppendUint32(nil, 1), 0, 0, 1, 0), valid[8:]...),
+		"truncated handle": valid[:len(valid)-1],
+		"missing entry":    append(binary.BigEndian.AppendUint32(nil, 2), valid[4:]...),
+	}
+
+	for name, data := range cases {
+		t.Run(name, func(t *testing.T) {
+			lift := func(unsafe.Pointer) *fakeObject {
+				t.Fatal("Lifted a handle from a malformed record")
+				return nil
+			}
+			_, _, _, err := decodeArchiveFiles(data, "Vec<PublicArchiveFileEntry>", lift, lift)
+			if !errors.Is(err, ErrMalformedResult) {
+				t.Fatalf("Expected ErrMalformedResult, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDecodeArchiveFilesTrailingBytesFreesHandles(t *testing.T) {
+	data := append(buildArchiveFiles(archiveFile{"a.txt", 0x1000, 0x1001}), 0)
+
+	var lifted []*fakeObject
+	lift := func(handle unsafe.Pointer) *fakeObject {
+		object := &fakeObject{handle: handle}
+		lifted = append(lifted, object)
+		return object
+	}
+	paths, _, _, err := decodeArchiveFiles(data, "Vec<PublicArchiveFileEntry>", lift, lift)
+	if !errors.Is(err, ErrMalformedResult) {
+		t.Fatalf("Expected ErrMalformedResult, got %v", err)
+	}
+	if paths != nil {
+		t.Fatal("Expected no entries with the error")
+	}
+	if len(lifted) != 2 || !lifted[0].freed || !lifted[1].freed {
+		t.Fatalf("Expected both handles freed, got %+v", lifted)
+	}
+}
+
+// The wrappers decoded below carry fake handles, so they are marked freed
+// before their finalizers can pass them to Rust.
+
+func TestDecodePublicArchiveFiles(t *testing.T) {
+	entries, err := decodePublicArchiveFiles(buildArchiveFiles(archiveFile{"a.txt", 0x3000, 0x3001}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		e.Address.freed, e.Metadata.freed = true, true
+	}
+	if len(entries) != 1 || entries[0].Path != "a.txt" || uintptr(entries[0].Address.handle) != 0x3000 || uintptr(entries[0].Metadata.handle) != 0x3001 {
+		t.Fatalf("Decoded %+v", entries)
+	}
+}
+
+func TestDecodePrivateArchiveFiles(t *testing.T) {
+	entries, err := decodePrivateArchiveFiles(buildArchiveFiles(archiveFile{"b.txt", 0x4000, 0x4001}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		e.DataMap.freed, e.Metadata.freed = true, true
+	}
+	if len(entries) != 1 || entries[0].Path != "b.txt" || uintptr(entries[0].DataMap.handle) != 0x4000 || uintptr(entries[0].Metadata.handle) != 0x4001 {
+		t.Fatalf("Decoded %+v", entries)
+	}
+
+	if _, err := decodePrivateArchiveFiles(buildArchiveFiles(archiveFile{"b.txt", 0x4000, 0x4001})[:10]); !errors.Is(err, ErrMalformedResult) {
+		t.Fatalf("Expected ErrMalformedResult, got %v", err)
+	}
+}
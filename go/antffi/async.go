@@ -3,6 +3,9 @@ package antffi
 /*
 #include <stdint.h>
 
+// Guarded because _cgo_export.h includes the preamble of every file with //export.
+#ifndef ANT_FFI_RUST_TYPES
+#define ANT_FFI_RUST_TYPES
 typedef struct {
     uint64_t capacity;
     uint64_t len;
@@ -13,6 +16,7 @@ typedef struct {
     int8_t code;
     RustBuffer error_buf;
 } RustCallStatus;
+#endif
 
 // Callback type for async polling
 typedef void (*UniffiRustFutureContinuationCallback)(uint64_t callback_data, int8_t poll_result);
@@ -47,13 +51,21 @@ import (
 	"unsafe"
 )
 
-// callbackRegistry stores channels for async callbacks
+// Poll results passed to the continuation callback.
+const (
+	pollReady      int8 = 0
+	pollMaybeReady int8 = 1
+)
+
+// callbackRegistry stores wake channels for async callbacks
 var (
 	callbackCounter uint64
 	callbackMap     sync.Map // map[uint64]chan int8
 )
 
-// registerCallback creates a channel for an async operation and returns its ID
+// registerCallback creates the wake channel for an async operation and returns its ID.
+// IDs are never reused, so a callback arriving after unregisterCallback is dropped
+// rather than delivered to another future.
 func registerCallback() (uint64, chan int8) {
 	id := atomic.AddUint64(&callbackCounter, 1)
 	ch := make(chan int8, 1)
@@ -68,11 +80,16 @@ func unregisterCallback(id uint64) {
 
 //export goAsyncCallback
 func goAsyncCallback(callbackData C.uint64_t, pollResult C.int8_t) {
-	id := uint64(callbackData)
+	wakeFuture(uint64(callbackData), int8(pollResult))
+}
+
+// wakeFuture delivers a poll result to the future registered under id.
+// Rust invokes the continuation once per poll, so the one-slot channel never blocks.
+func wakeFuture(id uint64, pollResult int8) {
 	if val, ok := callbackMap.Load(id); ok {
 		ch := val.(chan int8)
 		select {
-		case ch <- int8(pollResult):
+		case ch <- pollResult:
 		default:
 		}
 	}
@@ -87,92 +104,136 @@ const (
 	FutureTypeVoid
 )
 
-// pollFuture polls an async future until completion or context cancellation
-func pollFuture(ctx context.Context, futureHandle uint64, futureType FutureType) (unsafe.Pointer, C.RustBuffer, error) {
+// futureOutcome is the result of completing a future.
+type futureOutcome struct {
+	ptr      unsafe.Pointer
+	buf      C.RustBuffer
+	code     int8
+	errorBuf []byte
+}
+
+// futureDriver performs the rust_future_* calls for one kind of future.
+// poll must arrange for wakeFuture(callbackID, ...) to be called exactly once.
+type futureDriver interface {
+	poll(handle uint64, callbackID uint64)
+	complete(handle uint64) futureOutcome
+	cancel(handle uint64)
+	free(handle uint64)
+}
+
+// rustFutureDriver drives futures through the UniFFI scaffolding.
+type rustFutureDriver FutureType
+
+func (d rustFutureDriver) poll(handle uint64, callbackID uint64) {
+	callback := C.UniffiRustFutureContinuationCallback(C.goAsyncCallback)
+	switch FutureType(d) {
+	case FutureTypePointer:
+		C.ffi_ant_ffi_rust_future_poll_pointer(C.uint64_t(handle), callback, C.uint64_t(callbackID))
+	case FutureTypeRustBuffer:
+		C.ffi_ant_ffi_rust_future_poll_rust_buffer(C.uint64_t(handle), callback, C.uint64_t(callbackID))
+	case FutureTypeVoid:
+		C.ffi_ant_ffi_rust_future_poll_void(C.uint64_t(handle), callback, C.uint64_t(callbackID))
+	}
+}
+
+func (d rustFutureDriver) complete(handle uint64) futureOutcome {
+	var status C.RustCallStatus
+	var outcome futureOutcome
+	switch FutureType(d) {
+	case FutureTypePointer:
+		outcome.ptr = C.ffi_ant_ffi_rust_future_complete_pointer(C.uint64_t(handle), &status)
+	case FutureTypeRustBuffer:
+		outcome.buf = C.ffi_ant_ffi_rust_future_complete_rust_buffer(C.uint64_t(handle), &status)
+	case FutureTypeVoid:
+		C.ffi_ant_ffi_rust_future_complete_void(C.uint64_t(handle), &status)
+	}
+	outcome.code = int8(status.code)
+	if outcome.code != 0 {
+		outcome.errorBuf = fromRustBufferRaw(status.error_buf, true)
+	}
+	return outcome
+}
+
+func (d rustFutureDriver) cancel(handle uint64) {
+	switch FutureType(d) {
+	case FutureTypePointer:
+		C.ffi_ant_ffi_rust_future_cancel_pointer(C.uint64_t(handle))
+	case FutureTypeRustBuffer:
+		C.ffi_ant_ffi_rust_future_cancel_rust_buffer(C.uint64_t(handle))
+	case FutureTypeVoid:
+		C.ffi_ant_ffi_rust_future_cancel_void(C.uint64_t(handle))
+	}
+}
+
+func (d rustFutureDriver) free(handle uint64) {
+	switch FutureType(d) {
+	case FutureTypePointer:
+		C.ffi_ant_ffi_rust_future_free_pointer(C.uint64_t(handle))
+	case FutureTypeRustBuffer:
+		C.ffi_ant_ffi_rust_future_free_rust_buffer(C.uint64_t(handle))
+	case FutureTypeVoid:
+		C.ffi_ant_ffi_rust_future_free_void(C.uint64_t(handle))
+	}
+}
+
+// pollFuture polls an async future until completion or context cancellation.
+// lift decodes the Rust error enum the future fails with.
+func pollFuture(ctx context.Context, futureHandle uint64, futureType FutureType, lift errorLifter) (unsafe.Pointer, C.RustBuffer, error) {
+	outcome, err := driveFuture(ctx, futureHandle, rustFutureDriver(futureType))
+	if err != nil {
+		return nil, C.RustBuffer{}, err
+	}
+	if err := classifyStatus(outcome.code, outcome.errorBuf, "async operation", lift); err != nil {
+		return nil, C.RustBuffer{}, err
+	}
+	return outcome.ptr, outcome.buf, nil
+}
+
+// driveFuture polls a future to completion using a single callback registration
+// and wake channel for its whole lifetime.
+//
+// On cancellation the future is cancelled first, which makes Rust fire the
+// pending continuation; only after that wake arrives is the future freed and
+// the callback unregistered, so no continuation can outlive the future.
+func driveFuture(ctx context.Context, handle uint64, driver futureDriver) (futureOutcome, error) {
+	callbackID, wake := registerCallback()
+	defer unregisterCallback(callbackID)
+
 	for {
-		// Register callback
-		callbackID, ch := registerCallback()
-		defer unregisterCallback(callbackID)
-
-		// Start polling
-		callback := C.UniffiRustFutureContinuationCallback(C.goAsyncCallback)
-		switch futureType {
-		case FutureTypePointer:
-			C.ffi_ant_ffi_rust_future_poll_pointer(C.uint64_t(futureHandle), callback, C.uint64_t(callbackID))
-		case FutureTypeRustBuffer:
-			C.ffi_ant_ffi_rust_future_poll_rust_buffer(C.uint64_t(futureHandle), callback, C.uint64_t(callbackID))
-		case FutureTypeVoid:
-			C.ffi_ant_ffi_rust_future_poll_void(C.uint64_t(futureHandle), callback, C.uint64_t(callbackID))
-		}
+		driver.poll(handle, callbackID)
 
-		// Wait for callback or context cancellation
 		select {
 		case <-ctx.Done():
-			// Cancel the future
-			switch futureType {
-			case FutureTypePointer:
-				C.ffi_ant_ffi_rust_future_cancel_pointer(C.uint64_t(futureHandle))
-				C.ffi_ant_ffi_rust_future_free_pointer(C.uint64_t(futureHandle))
-			case FutureTypeRustBuffer:
-				C.ffi_ant_ffi_rust_future_cancel_rust_buffer(C.uint64_t(futureHandle))
-				C.ffi_ant_ffi_rust_future_free_rust_buffer(C.uint64_t(futureHandle))
-			case FutureTypeVoid:
-				C.ffi_ant_ffi_rust_future_cancel_void(C.uint64_t(futureHandle))
-				C.ffi_ant_ffi_rust_future_free_void(C.uint64_t(futureHandle))
-			}
-			return nil, C.RustBuffer{}, ctx.Err()
-
-		case pollResult := <-ch:
-			// POLL_READY = 0, POLL_MAYBE_READY = 1
-			if pollResult == 0 {
-				// Future is ready, complete it
-				var status C.RustCallStatus
-				switch futureType {
-				case FutureTypePointer:
-					ptr := C.ffi_ant_ffi_rust_future_complete_pointer(C.uint64_t(futureHandle), &status)
-					C.ffi_ant_ffi_rust_future_free_pointer(C.uint64_t(futureHandle))
-					if err := checkStatus(&status, "async operation"); err != nil {
-						return nil, C.RustBuffer{}, err
-					}
-					return ptr, C.RustBuffer{}, nil
-
-				case FutureTypeRustBuffer:
-					buf := C.ffi_ant_ffi_rust_future_complete_rust_buffer(C.uint64_t(futureHandle), &status)
-					C.ffi_ant_ffi_rust_future_free_rust_buffer(C.uint64_t(futureHandle))
-					if err := checkStatus(&status, "async operation"); err != nil {
-						return nil, C.RustBuffer{}, err
-					}
-					return nil, buf, nil
-
-				case FutureTypeVoid:
-					C.ffi_ant_ffi_rust_future_complete_void(C.uint64_t(futureHandle), &status)
-					C.ffi_ant_ffi_rust_future_free_void(C.uint64_t(futureHandle))
-					if err := checkStatus(&status, "async operation"); err != nil {
-						return nil, C.RustBuffer{}, err
-					}
-					return nil, C.RustBuffer{}, nil
-				}
+			driver.cancel(handle)
+			<-wake
+			driver.free(handle)
+			return futureOutcome{}, contextError(ctx)
+
+		case pollResult := <-wake:
+			if pollResult == pollReady {
+				outcome := driver.complete(handle)
+				driver.free(handle)
+				return outcome, nil
 			}
 			// Poll again if POLL_MAYBE_READY
-			continue
 		}
 	}
 }
 
-// pollPointerFuture polls a pointer-returning future
+// pollPointerFuture polls a pointer-returning future that fails with a ClientError
 func pollPointerFuture(ctx context.Context, futureHandle uint64) (unsafe.Pointer, error) {
-	ptr, _, err := pollFuture(ctx, futureHandle, FutureTypePointer)
+	ptr, _, err := pollFuture(ctx, futureHandle, FutureTypePointer, liftClientError)
 	return ptr, err
 }
 
-// pollRustBufferFuture polls a RustBuffer-returning future
+// pollRustBufferFuture polls a RustBuffer-returning future that fails with a ClientError
 func pollRustBufferFuture(ctx context.Context, futureHandle uint64) (C.RustBuffer, error) {
-	_, buf, err := pollFuture(ctx, futureHandle, FutureTypeRustBuffer)
+	_, buf, err := pollFuture(ctx, futureHandle, FutureTypeRustBuffer, liftClientError)
 	return buf, err
 }
 
-// pollVoidFuture polls a void-returning future
+// pollVoidFuture polls a void-returning future that fails with a ClientError
 func pollVoidFuture(ctx context.Context, futureHandle uint64) error {
-	_, _, err := pollFuture(ctx, futureHandle, FutureTypeVoid)
+	_, _, err := pollFuture(ctx, futureHandle, FutureTypeVoid, liftClientError)
 	return err
 }
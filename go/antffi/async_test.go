@@ -0,0 +1,239 @@
+package antffi
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeFuture is a futureDriver that mimics the UniFFI rust_future scheduler:
+// each poll fires its continuation exactly once from another goroutine, and
+// cancel fires a pending continuation with POLL_READY.
+type fakeFuture struct {
+	mu sync.Mutex
+
+	maybeReady int  // MAYBE_READY results to report before READY
+	block      bool // never become ready on its own
+
+	pending      uint64 // callback ID of a blocked poll
+	outstanding  bool   // a poll's continuation has not fired yet
+	callbackIDs  map[uint64]bool
+	polls        int
+	completes    int
+	cancels      int
+	frees        int
+	violation    string
+	maxCallbacks int
+}
+
+func newFakeFuture(maybeReady int, block bool) *fakeFuture {
+	return &fakeFuture{maybeReady: maybeReady, block: block, callbackIDs: map[uint64]bool{}}
+}
+
+func (f *fakeFuture) poll(handle uint64, callbackID uint64) {
+	f.mu.Lock()
+	f.polls++
+	f.callbackIDs[callbackID] = true
+	if f.frees > 0 {
+		f.violation = "poll after free"
+	}
+	if n := registeredCallbacks(); n > f.maxCallbacks {
+		f.maxCallbacks = n
+	}
+	f.outstanding = true
+	if f.block {
+		f.pending = callbackID
+		f.mu.Unlock()
+		return
+	}
+	result := pollReady
+	if f.maybeReady > 0 {
+		f.maybeReady--
+		result = pollMaybeReady
+	}
+	f.mu.Unlock()
+
+	go f.fire(callbackID, result)
+}
+
+// fire runs the continuation for the outstanding poll.
+func (f *fakeFuture) fire(callbackID uint64, result int8) {
+	f.mu.Lock()
+	f.outstanding = false
+	f.mu.Unlock()
+	wakeFuture(callbackID, result)
+}
+
+func (f *fakeFuture) complete(handle uint64) futureOutcome {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.completes++
+	return futureOutcome{}
+}
+
+func (f *fakeFuture) cancel(handle uint64) {
+	f.mu.Lock()
+	f.cancels++
+	id := f.pending
+	f.pending = 0
+	f.mu.Unlock()
+
+	if id != 0 {
+		go f.fire(id, pollReady)
+	}
+}
+
+func (f *fakeFuture) free(handle uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.frees++
+	if f.outstanding {
+		f.violation = "free before pending continuation fired"
+	}
+}
+
+// registeredCallbacks counts the entries in the callback registry.
+func registeredCallbacks() int {
+	n := 0
+	callbackMap.Range(func(_, _ interface{}) bool {
+		n++
+		return true
+	})
+	return n
+}
+
+func TestDriveFutureSingleRegistration(t *testing.T) {
+	f := newFakeFuture(50, false)
+
+	if _, err := driveFuture(context.Background(), 1, f); err != nil {
+		t.Fatalf("driveFuture failed: %v", err)
+	}
+
+	if f.polls != 51 || f.completes != 1 || f.frees != 1 {
+		t.Fatalf("Unexpected calls: polls=%d completes=%d frees=%d", f.polls, f.completes, f.frees)
+	}
+	if len(f.callbackIDs) != 1 {
+		t.Fatalf("Expected one callback registration, got %d", len(f.callbackIDs))
+	}
+	if f.maxCallbacks != 1 {
+		t.Fatalf("Registry grew to %d entries while polling", f.maxCallbacks)
+	}
+	if n := registeredCallbacks(); n != 0 {
+		t.Fatalf("Registry still holds %d entries", n)
+	}
+}
+
+func TestDriveFutureCancelOrder(t *testing.T) {
+	f := newFakeFuture(0, true)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := driveFuture(ctx, 1, f)
+		done <- err
+	}()
+
+	time.Sleep(5 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != context.Canceled {
+			t.Fatalf("Expected context.Canceled, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("driveFuture did not return after cancellation")
+	}
+
+	if f.violation != "" {
+		t.Fatal(f.violation)
+	}
+	if f.cancels != 1 || f.frees != 1 || f.completes != 0 {
+		t.Fatalf("Unexpected calls: cancels=%d frees=%d completes=%d", f.cancels, f.frees, f.completes)
+	}
+	if n := registeredCallbacks(); n != 0 {
+		t.Fatalf("Registry still holds %d entries", n)
+	}
+}
+
+func TestWakeFutureAfterUnregister(t *testing.T) {
+	id, ch := registerCallback()
+	unregisterCallback(id)
+
+	// A late continuation must be dropped without blocking or panicking.
+	wakeFuture(id, pollReady)
+	wakeFuture(id, pollMaybeReady)
+
+	select {
+	case <-ch:
+		t.Fatal("Late callback was delivered to an unregistered future")
+	default:
+	}
+}
+
+func TestDriveFutureStress(t *testing.T) {
+	const futures = 2000
+
+	var wg sync.WaitGroup
+	var cancelled int64
+	fakes := make([]*fakeFuture, futures)
+
+	for i := 0; i < futures; i++ {
+		f := newFakeFuture(i%7, i%3 == 0)
+		fakes[i] = f
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), time.Duration(i%5)*time.Millisecond)
+			defer cancel()
+			if _, err := driveFuture(ctx, uint64(i), f); err != nil {
+				atomic.AddInt64(&cancelled, 1)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for i, f := range fakes {
+		if f.violation != "" {
+			t.Fatalf("future %d: %s", i, f.violation)
+		}
+		if f.frees != 1 {
+			t.Fatalf("future %d freed %d times", i, f.frees)
+		}
+		if len(f.callbackIDs) != 1 {
+			t.Fatalf("future %d registered %d callbacks", i, len(f.callbackIDs))
+		}
+	}
+	if atomic.LoadInt64(&cancelled) < futures/3 {
+		t.Fatalf("Expected at least %d cancellations, got %d", futures/3, cancelled)
+	}
+	if n := registeredCallbacks(); n != 0 {
+		t.Fatalf("Registry still holds %d entries", n)
+	}
+}
+
+func BenchmarkDriveFuture(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := driveFuture(context.Background(), uint64(i), newFakeFuture(8, false)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDriveFutureParallel(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := driveFuture(context.Background(), 1, newFakeFuture(8, false)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	if n := registeredCallbacks(); n != 0 {
+		b.Fatalf("Registry still holds %d entries", n)
+	}
+}
@@ -17,6 +17,7 @@ typedef struct {
 // Client constructors (Async)
 extern uint64_t uniffi_ant_ffi_fn_constructor_client_init();
 extern uint64_t uniffi_ant_ffi_fn_constructor_client_init_local();
+extern uint64_t uniffi_ant_ffi_fn_constructor_client_init_with_peers(RustBuffer peers, void* evmNetwork, RustBuffer dataDir);
 extern void uniffi_ant_ffi_fn_free_client(void* ptr, RustCallStatus* status);
 extern void* uniffi_ant_ffi_fn_clone_client(void* ptr, RustCallStatus* status);
 
@@ -24,6 +25,7 @@ extern void* uniffi_ant_ffi_fn_clone_client(void* ptr, RustCallStatus* status);
 extern uint64_t uniffi_ant_ffi_fn_method_client_data_put_public(void* ptr, RustBuffer data, RustBuffer payment);
 extern uint64_t uniffi_ant_ffi_fn_method_client_data_get_public(void* ptr, RustBuffer addressHex);
 extern uint64_t uniffi_ant_ffi_fn_method_client_data_put(void* ptr, RustBuffer data, RustBuffer payment);
+extern uint64_t uniffi_ant_ffi_fn_method_client_data_put_with_progress(void* ptr, RustBuffer data, RustBuffer payment, uint64_t listener);
 extern uint64_t uniffi_ant_ffi_fn_method_client_data_get(void* ptr, void* dataMapChunk);
 extern uint64_t uniffi_ant_ffi_fn_method_client_data_cost(void* ptr, RustBuffer data);
 
@@ -32,6 +34,8 @@ extern uint64_t uniffi_ant_ffi_fn_method_client_file_upload_public(void* ptr, Ru
 extern uint64_t uniffi_ant_ffi_fn_method_client_file_download_public(void* ptr, void* address, RustBuffer destPath);
 extern uint64_t uniffi_ant_ffi_fn_method_client_file_upload(void* ptr, RustBuffer filePath, RustBuffer payment);
 extern uint64_t uniffi_ant_ffi_fn_method_client_file_download(void* ptr, void* dataMapChunk, RustBuffer destPath);
+extern uint64_t uniffi_ant_ffi_fn_method_client_file_upload_with_progress(void* ptr, RustBuffer filePath, RustBuffer payment, uint64_t listener);
+extern uint64_t uniffi_ant_ffi_fn_method_client_file_download_with_progress(void* ptr, void* dataMapChunk, RustBuffer destPath, uint64_t listener);
 extern uint64_t uniffi_ant_ffi_fn_method_client_file_cost(void* ptr, RustBuffer filePath);
 
 // Client - Chunk Operations (Async)
@@ -43,7 +47,8 @@ extern uint64_t uniffi_ant_ffi_fn_method_client_chunk_cost(void* ptr, void* addr
 extern uint64_t uniffi_ant_ffi_fn_method_client_pointer_get(void* ptr, void* address);
 extern uint64_t uniffi_ant_ffi_fn_method_client_pointer_put(void* ptr, void* pointer, RustBuffer payment);
 extern uint64_t uniffi_ant_ffi_fn_method_client_pointer_create(void* ptr, void* owner, void* target, RustBuffer payment);
-extern uint64_t uniffi_ant_ffi_fn_method_client_pointer_update(void* ptr, void* owner, void* target, RustBuffer payment);
+extern uint64_t uniffi_ant_ffi_fn_method_client_pointer_update(void* ptr, void* owner, void* target);
+extern uint64_t uniffi_ant_ffi_fn_method_client_pointer_update_from(void* ptr, void* current, void* owner, void* target);
 extern uint64_t uniffi_ant_ffi_fn_method_client_pointer_cost(void* ptr, void* key);
 extern uint64_t uniffi_ant_ffi_fn_method_client_pointer_check_existence(void* ptr, void* address);
 
@@ -58,7 +63,9 @@ extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_get(void* ptr, void*
 extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_put(void* ptr, void* scratchpad, RustBuffer payment);
 extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_get_from_public_key(void* ptr, void* publicKey);
 extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_create(void* ptr, void* owner, uint64_t contentType, RustBuffer initialData, RustBuffer payment);
-extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_update(void* ptr, void* owner, uint64_t contentType, RustBuffer data, RustBuffer payment);
+extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_update(void* ptr, void* owner, uint64_t contentType, RustBuffer data);
+extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_update_from(void* ptr, void* current, void* owner, uint64_t contentType, RustBuffer data);
+extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_put_update(void* ptr, void* scratchpad);
 extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_cost(void* ptr, void* publicKey);
 extern uint64_t uniffi_ant_ffi_fn_method_client_scratchpad_check_existence(void* ptr, void* address);
 
@@ -67,27 +74,36 @@ extern uint64_t uniffi_ant_ffi_fn_method_client_register_get(void* ptr, void* ad
 extern uint64_t uniffi_ant_ffi_fn_method_client_register_create(void* ptr, void* owner, RustBuffer value, RustBuffer payment);
 extern uint64_t uniffi_ant_ffi_fn_method_client_register_update(void* ptr, void* owner, RustBuffer value, RustBuffer payment);
 extern uint64_t uniffi_ant_ffi_fn_method_client_register_cost(void* ptr, void* owner);
+extern void* uniffi_ant_ffi_fn_method_client_register_history(void* ptr, void* address, RustCallStatus* status);
 
 // Client - Vault Operations (Async)
 extern uint64_t uniffi_ant_ffi_fn_method_client_vault_get_user_data(void* ptr, void* secretKey);
 extern uint64_t uniffi_ant_ffi_fn_method_client_vault_put_user_data(void* ptr, void* secretKey, RustBuffer payment, void* userData);
 extern uint64_t uniffi_ant_ffi_fn_method_client_vault_cost(void* ptr, void* key, uint64_t maxSize);
+extern uint64_t uniffi_ant_ffi_fn_method_client_vault_get(void* ptr, void* key);
+extern uint64_t uniffi_ant_ffi_fn_method_client_vault_put(void* ptr, RustBuffer data, RustBuffer payment, void* key, uint64_t contentType);
 
 // Client - Archive Operations (Async)
 extern uint64_t uniffi_ant_ffi_fn_method_client_archive_get_public(void* ptr, void* address);
 extern uint64_t uniffi_ant_ffi_fn_method_client_archive_put_public(void* ptr, void* archive, RustBuffer payment);
 extern uint64_t uniffi_ant_ffi_fn_method_client_archive_cost(void* ptr, void* archive);
+extern uint64_t uniffi_ant_ffi_fn_method_client_archive_get(void* ptr, void* dataMap);
+extern uint64_t uniffi_ant_ffi_fn_method_client_archive_put(void* ptr, void* archive, RustBuffer payment);
 
 // Client - Directory Operations (Async)
-extern uint64_t uniffi_ant_ffi_fn_method_client_dir_upload(void* ptr, RustBuffer path, RustBuffer payment);
-extern uint64_t uniffi_ant_ffi_fn_method_client_dir_upload_public(void* ptr, RustBuffer path, RustBuffer payment);
+extern uint64_t uniffi_ant_ffi_fn_method_client_dir_upload(void* ptr, RustBuffer path, void* wallet);
+extern uint64_t uniffi_ant_ffi_fn_method_client_dir_content_upload(void* ptr, RustBuffer path, RustBuffer payment);
+extern uint64_t uniffi_ant_ffi_fn_method_client_dir_upload_public(void* ptr, RustBuffer path, void* wallet);
 extern uint64_t uniffi_ant_ffi_fn_method_client_dir_download(void* ptr, void* dataMap, RustBuffer destPath);
+extern uint64_t uniffi_ant_ffi_fn_method_client_dir_upload_with_progress(void* ptr, RustBuffer path, void* wallet, uint64_t listener);
+extern uint64_t uniffi_ant_ffi_fn_method_client_dir_download_with_progress(void* ptr, void* dataMap, RustBuffer destPath, uint64_t listener);
 extern uint64_t uniffi_ant_ffi_fn_method_client_dir_download_public(void* ptr, void* address, RustBuffer destPath);
 */
 import "C"
 
 import (
 	"context"
+	"fmt"
 	"runtime"
 	"sync"
 	"unsafe"
@@ -108,66 +124,178 @@ func getPaymentBuffer(payment *PaymentOption) C.RustBuffer {
 	if walletHandle == nil {
 		return C.RustBuffer{}
 	}
+	consumeClones(walletHandle)
 	return lowerPaymentOption(walletHandle)
 }
 
 // Client represents a connection to the Autonomi network.
 type Client struct {
-	handle unsafe.Pointer
-	freed  bool
-	mu     sync.Mutex
+	handle  unsafe.Pointer
+	calls   callRefs
+	mu      sync.Mutex
+	retry   RetryPolicy
+	options ClientOptions
 }
 
 // NewClient creates a new client connected to the production network.
-func NewClient(ctx context.Context) (*Client, error) {
+// opts sets the client's default timeouts; omitted options and zero fields use DefaultClientOptions.
+func NewClient(ctx context.Context, opts ...ClientOptions) (*Client, error) {
+	options := resolveClientOptions(opts)
+	ctx, cancel := withDefaultTimeout(ctx, options, opConnect, "NewClient")
+	defer cancel()
+
 	futureHandle := uint64(C.uniffi_ant_ffi_fn_constructor_client_init())
 	ptr, err := pollPointerFuture(ctx, futureHandle)
 	if err != nil {
 		return nil, err
 	}
-	return newClient(ptr), nil
+	return newClient(ptr, options), nil
 }
 
 // NewClientLocal creates a new client connected to a local testnet.
-func NewClientLocal(ctx context.Context) (*Client, error) {
+// opts sets the client's default timeouts; omitted options and zero fields use DefaultClientOptions.
+func NewClientLocal(ctx context.Context, opts ...ClientOptions) (*Client, error) {
+	options := resolveClientOptions(opts)
+	ctx, cancel := withDefaultTimeout(ctx, options, opConnect, "NewClientLocal")
+	defer cancel()
+
 	futureHandle := uint64(C.uniffi_ant_ffi_fn_constructor_client_init_local())
 	ptr, err := pollPointerFuture(ctx, futureHandle)
 	if err != nil {
 		return nil, err
 	}
-	return newClient(ptr), nil
+	return newClient(ptr, options), nil
+}
+
+// ClientConfig configures a client that bootstraps from explicit peers.
+type ClientConfig struct {
+	// Peers is the list of bootstrap peer multiaddrs (e.g. "/ip4/10.0.2.2/tcp/12000").
+	Peers []string
+	// Network is the EVM network used for payments; it must match the wallet's network.
+	Network *Network
+	// DataDir is an optional directory for client data. Leave empty to use the default.
+	DataDir string
+}
+
+// NewClientWithConfig creates a new client that bootstraps from the configured peers,
+// e.g. for private testnets or staging networks.
+// Peers are validated before connecting; an invalid peer yields a *PeerError,
+// and an empty Peers list yields ErrInvalidArgument.
+// opts sets the client's default timeouts; omitted options and zero fields use DefaultClientOptions.
+func NewClientWithConfig(ctx context.Context, config ClientConfig, opts ...ClientOptions) (*Client, error) {
+	if len(config.Peers) == 0 {
+		return nil, fmt.Errorf("%w: no peers provided", ErrInvalidArgument)
+	}
+	for _, peer := range config.Peers {
+		if err := validateMultiaddr(peer); err != nil {
+			return nil, err
+		}
+	}
+	if config.Network == nil {
+		return nil, ErrNilPointer
+	}
+
+	networkCloned := config.Network.CloneHandle()
+	if networkCloned == nil {
+		return nil, ErrDisposed
+	}
+
+	var dataDir *string
+	if config.DataDir != "" {
+		dataDir = &config.DataDir
+	}
+
+	options := resolveClientOptions(opts)
+	ctx, cancel := withDefaultTimeout(ctx, options, opConnect, "NewClientWithConfig")
+	defer cancel()
+
+	peersBuffer := stringSliceToRustBuffer(config.Peers)
+	dataDirBuffer := optionStringToRustBuffer(dataDir)
+
+	consumeClones(networkCloned)
+	futureHandle := uint64(C.uniffi_ant_ffi_fn_constructor_client_init_with_peers(peersBuffer, networkCloned, dataDirBuffer))
+	ptr, err := pollPointerFuture(ctx, futureHandle)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(ptr, options), nil
 }
 
-func newClient(handle unsafe.Pointer) *Client {
-	c := &Client{handle: handle}
+func newClient(handle unsafe.Pointer, options ClientOptions) *Client {
+	c := &Client{handle: handle, options: options}
 	runtime.SetFinalizer(c, (*Client).Free)
+	trackHandle(unsafe.Pointer(c), "Client", c.handle)
 	return c
 }
 
 // Free releases the client resources.
+// Calls made after Free fail with ErrDisposed. Calls already in flight run to
+// completion, and the native handle is released once the last of them returns.
 func (c *Client) Free() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.freed || c.handle == nil {
-		return
+	if c.calls.close() && c.handle != nil {
+		c.releaseHandle()
 	}
+}
+
+// Close implements io.Closer by calling Free. It always returns nil.
+func (c *Client) Close() error {
+	c.Free()
+	return nil
+}
 
+// releaseHandle frees the native handle. c.mu must be held.
+func (c *Client) releaseHandle() {
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_client(c.handle, &status)
-	c.freed = true
+	untrackHandle(unsafe.Pointer(c))
+	c.handle = nil
+}
+
+// acquire starts an in-flight call and returns a cloned handle for it.
+// It fails with ErrDisposed once Free has been called. Every successful
+// acquire must be paired with a release when the call returns.
+func (c *Client) acquire() (unsafe.Pointer, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.handle == nil {
+		return nil, ErrDisposed
+	}
+	if err := c.calls.begin(); err != nil {
+		return nil, err
+	}
+	return c.cloneHandle(), nil
+}
+
+// release ends an in-flight call started by acquire, releasing the native
+// handle if Free was called while the call was running.
+func (c *Client) release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.calls.end() {
+		c.releaseHandle()
+	}
+}
+
+// freeClone frees a handle returned by acquire that was not passed to an FFI call.
+func (c *Client) freeClone(cloned unsafe.Pointer) {
+	consumeClones(cloned)
+	var status C.RustCallStatus
+	C.uniffi_ant_ffi_fn_free_client(cloned, &status)
 }
 
 func (c *Client) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_client(c.handle, &status)
+	return trackClone("Client", C.uniffi_ant_ffi_fn_clone_client(c.handle, &status))
 }
 
 // CloneHandle returns a cloned handle for FFI operations.
 func (c *Client) CloneHandle() unsafe.Pointer {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	if c.freed {
+	if c.calls.closed || c.handle == nil {
 		return nil
 	}
 	return c.cloneHandle()
@@ -186,17 +314,19 @@ type UploadResult struct {
 // DataPutPublic uploads public data to the network.
 // Returns an UploadResult with the price and address.
 func (c *Client) DataPutPublic(ctx context.Context, data []byte, payment *PaymentOption) (*UploadResult, error) {
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
+	ctx, cancel := c.withTimeout(ctx, opWrite, "DataPutPublic")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	dataBuffer := toRustBuffer(data)
 	paymentBuffer := getPaymentBuffer(payment)
 
+	consumeClones(cloned)
 	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_data_put_public(cloned, dataBuffer, paymentBuffer))
 	buf, err := pollRustBufferFuture(ctx, futureHandle)
 	if err != nil {
@@ -214,23 +344,27 @@ func (c *Client) DataPutPublic(ctx context.Context, data []byte, payment *Paymen
 
 // DataGetPublic retrieves public data from the network by address.
 func (c *Client) DataGetPublic(ctx context.Context, addressHex string) ([]byte, error) {
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
-
-	addressBuffer := stringToRustBuffer(addressHex)
-
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_data_get_public(cloned, addressBuffer))
-	buf, err := pollRustBufferFuture(ctx, futureHandle)
-	if err != nil {
-		return nil, err
-	}
-
-	return fromRustBuffer(buf, true), nil
+	ctx, cancel := c.withTimeout(ctx, opRead, "DataGetPublic")
+	defer cancel()
+
+	return retryCall(ctx, c, func(ctx context.Context) ([]byte, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return nil, err
+		}
+		defer c.release()
+
+		addressBuffer := stringToRustBuffer(addressHex)
+
+		consumeClones(cloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_data_get_public(cloned, addressBuffer))
+		buf, err := pollRustBufferFuture(ctx, futureHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		return fromRustBuffer(buf, true), nil
+	})
 }
 
 // DataPutResult represents the result of uploading private data.
@@ -243,34 +377,35 @@ type DataPutResult struct {
 
 // DataPut uploads private (self-encrypted) data to the network.
 // Returns a DataPutResult with the cost and data map.
-func (c *Client) DataPut(ctx context.Context, data []byte, payment *PaymentOption) (*DataPutResult, error) {
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
+// opts may set a ProgressFunc; see TransferOptions.
+func (c *Client) DataPut(ctx context.Context, data []byte, payment *PaymentOption, opts ...TransferOptions) (*DataPutResult, error) {
+	ctx, cancel := c.withTimeout(ctx, opWrite, "DataPut")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	dataBuffer := toRustBuffer(data)
 	paymentBuffer := getPaymentBuffer(payment)
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_data_put(cloned, dataBuffer, paymentBuffer))
+	var futureHandle uint64
+	if progress := newProgressReporter(opts); progress != nil {
+		defer progress.close()
+		consumeClones(cloned)
+		futureHandle = uint64(C.uniffi_ant_ffi_fn_method_client_data_put_with_progress(cloned, dataBuffer, paymentBuffer, progress.lower()))
+	} else {
+		consumeClones(cloned)
+		futureHandle = uint64(C.uniffi_ant_ffi_fn_method_client_data_put(cloned, dataBuffer, paymentBuffer))
+	}
 	buf, err := pollRustBufferFuture(ctx, futureHandle)
 	if err != nil {
 		return nil, err
 	}
 
-	// Deserialize DataPutResult record (cost: String, data_map: Arc<DataMapChunk>)
-	rawBytes := fromRustBufferRaw(buf, true)
-	reader := NewUniFFIReader(rawBytes)
-	cost := reader.ReadString()
-	dataMapPtr := reader.ReadPointer()
-
-	return &DataPutResult{
-		Cost:    cost,
-		DataMap: newDataMapChunk(dataMapPtr),
-	}, nil
+	return decodeDataPutResult(fromRustBufferRaw(buf, true))
 }
 
 // DataGet retrieves private (self-encrypted) data from the network.
@@ -279,72 +414,91 @@ func (c *Client) DataGet(ctx context.Context, dataMapChunk *DataMapChunk) ([]byt
 		return nil, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	ctx, cancel := c.withTimeout(ctx, opRead, "DataGet")
+	defer cancel()
 
-	dataMapCloned := dataMapChunk.CloneHandle()
-	if dataMapCloned == nil {
-		return nil, ErrDisposed
-	}
+	return retryCall(ctx, c, func(ctx context.Context) ([]byte, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return nil, err
+		}
+		defer c.release()
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_data_get(cloned, dataMapCloned))
-	buf, err := pollRustBufferFuture(ctx, futureHandle)
-	if err != nil {
-		return nil, err
-	}
+		dataMapCloned := dataMapChunk.CloneHandle()
+		if dataMapCloned == nil {
+			c.freeClone(cloned)
+			return nil, ErrDisposed
+		}
 
-	return fromRustBuffer(buf, true), nil
+		consumeClones(cloned, dataMapCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_data_get(cloned, dataMapCloned))
+		buf, err := pollRustBufferFuture(ctx, futureHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		return fromRustBuffer(buf, true), nil
+	})
 }
 
 // DataCost calculates the cost to store data on the network.
 func (c *Client) DataCost(ctx context.Context, data []byte) (string, error) {
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return "", ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
-
-	dataBuffer := toRustBuffer(data)
-
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_data_cost(cloned, dataBuffer))
-	buf, err := pollRustBufferFuture(ctx, futureHandle)
-	if err != nil {
-		return "", err
-	}
-
-	return stringFromRustBuffer(buf), nil
+	ctx, cancel := c.withTimeout(ctx, opQuote, "DataCost")
+	defer cancel()
+
+	return retryCall(ctx, c, func(ctx context.Context) (string, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return "", err
+		}
+		defer c.release()
+
+		dataBuffer := toRustBuffer(data)
+
+		consumeClones(cloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_data_cost(cloned, dataBuffer))
+		buf, err := pollRustBufferFuture(ctx, futureHandle)
+		if err != nil {
+			return "", err
+		}
+
+		return stringFromRustBuffer(buf), nil
+	})
 }
 
 // ========== File Operations ==========
 
+// FileUploadPublicResult represents the result of uploading a public file.
+type FileUploadPublicResult struct {
+	// The cost paid in tokens
+	Cost string
+	// The address where the file was stored
+	Address *DataAddress
+}
+
 // FileUploadPublic uploads a public file to the network.
-// Returns the address where the file was stored.
-func (c *Client) FileUploadPublic(ctx context.Context, filePath string, payment *PaymentOption) (string, error) {
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return "", ErrDisposed
+// Returns a FileUploadPublicResult with the cost and the file address.
+func (c *Client) FileUploadPublic(ctx context.Context, filePath string, payment *PaymentOption) (*FileUploadPublicResult, error) {
+	ctx, cancel := c.withTimeout(ctx, opTransfer, "FileUploadPublic")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	filePathBuffer := stringToRustBuffer(filePath)
 	paymentBuffer := getPaymentBuffer(payment)
 
+	consumeClones(cloned)
 	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_file_upload_public(cloned, filePathBuffer, paymentBuffer))
 	buf, err := pollRustBufferFuture(ctx, futureHandle)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return stringFromRustBuffer(buf), nil
+	return decodeFileUploadPublicResult(fromRustBufferRaw(buf, true))
 }
 
 // FileDownloadPublic downloads a public file from the network.
@@ -353,115 +507,170 @@ func (c *Client) FileDownloadPublic(ctx context.Context, address *DataAddress, d
 		return ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	ctx, cancel := c.withTimeout(ctx, opTransfer, "FileDownloadPublic")
+	defer cancel()
 
-	addressCloned := address.CloneHandle()
-	if addressCloned == nil {
-		return ErrDisposed
-	}
-	destPathBuffer := stringToRustBuffer(destPath)
+	return retryVoidCall(ctx, c, func(ctx context.Context) error {
+		cloned, err := c.acquire()
+		if err != nil {
+			return err
+		}
+		defer c.release()
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_file_download_public(cloned, addressCloned, destPathBuffer))
-	return pollVoidFuture(ctx, futureHandle)
+		addressCloned := address.CloneHandle()
+		if addressCloned == nil {
+			c.freeClone(cloned)
+			return ErrDisposed
+		}
+		destPathBuffer := stringToRustBuffer(destPath)
+
+		consumeClones(cloned, addressCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_file_download_public(cloned, addressCloned, destPathBuffer))
+		return pollVoidFuture(ctx, futureHandle)
+	})
+}
+
+// FileUploadResult represents the result of uploading a private file.
+type FileUploadResult struct {
+	// The cost paid in tokens
+	Cost string
+	// The data map to retrieve the file
+	DataMap *DataMapChunk
 }
 
 // FileUpload uploads a private (self-encrypted) file to the network.
-// Returns a DataMapChunk that can be used to retrieve the file.
-func (c *Client) FileUpload(ctx context.Context, filePath string, payment *PaymentOption) (*DataMapChunk, error) {
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
+// Returns a FileUploadResult with the cost and the data map to retrieve the file.
+// opts may set a ProgressFunc; see TransferOptions.
+func (c *Client) FileUpload(ctx context.Context, filePath string, payment *PaymentOption, opts ...TransferOptions) (*FileUploadResult, error) {
+	ctx, cancel := c.withTimeout(ctx, opTransfer, "FileUpload")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	filePathBuffer := stringToRustBuffer(filePath)
 	paymentBuffer := getPaymentBuffer(payment)
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_file_upload(cloned, filePathBuffer, paymentBuffer))
-	ptr, err := pollPointerFuture(ctx, futureHandle)
+	var futureHandle uint64
+	if progress := newProgressReporter(opts); progress != nil {
+		defer progress.close()
+		consumeClones(cloned)
+		futureHandle = uint64(C.uniffi_ant_ffi_fn_method_client_file_upload_with_progress(cloned, filePathBuffer, paymentBuffer, progress.lower()))
+	} else {
+		consumeClones(cloned)
+		futureHandle = uint64(C.uniffi_ant_ffi_fn_method_client_file_upload(cloned, filePathBuffer, paymentBuffer))
+	}
+	buf, err := pollRustBufferFuture(ctx, futureHandle)
 	if err != nil {
 		return nil, err
 	}
 
-	return newDataMapChunk(ptr), nil
+	return decodeFileUploadResult(fromRustBufferRaw(buf, true))
 }
 
 // FileDownload downloads a private (self-encrypted) file from the network.
-func (c *Client) FileDownload(ctx context.Context, dataMapChunk *DataMapChunk, destPath string) error {
+// opts may set a ProgressFunc; see TransferOptions. A retried download reports
+// ProgressStarted again and counts from zero.
+func (c *Client) FileDownload(ctx context.Context, dataMapChunk *DataMapChunk, destPath string, opts ...TransferOptions) error {
 	if dataMapChunk == nil {
 		return ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
-
-	dataMapCloned := dataMapChunk.CloneHandle()
-	if dataMapCloned == nil {
-		return ErrDisposed
-	}
-	destPathBuffer := stringToRustBuffer(destPath)
-
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_file_download(cloned, dataMapCloned, destPathBuffer))
-	return pollVoidFuture(ctx, futureHandle)
+	ctx, cancel := c.withTimeout(ctx, opTransfer, "FileDownload")
+	defer cancel()
+
+	progress := newProgressReporter(opts)
+	if progress != nil {
+		defer progress.close()
+	}
+
+	return retryVoidCall(ctx, c, func(ctx context.Context) error {
+		cloned, err := c.acquire()
+		if err != nil {
+			return err
+		}
+		defer c.release()
+
+		dataMapCloned := dataMapChunk.CloneHandle()
+		if dataMapCloned == nil {
+			c.freeClone(cloned)
+			return ErrDisposed
+		}
+		destPathBuffer := stringToRustBuffer(destPath)
+
+		var futureHandle uint64
+		if progress != nil {
+			consumeClones(cloned, dataMapCloned)
+			futureHandle = uint64(C.uniffi_ant_ffi_fn_method_client_file_download_with_progress(cloned, dataMapCloned, destPathBuffer, progress.lower()))
+		} else {
+			consumeClones(cloned, dataMapCloned)
+			futureHandle = uint64(C.uniffi_ant_ffi_fn_method_client_file_download(cloned, dataMapCloned, destPathBuffer))
+		}
+		return pollVoidFuture(ctx, futureHandle)
+	})
 }
 
 // FileCost calculates the cost to store a file on the network.
 func (c *Client) FileCost(ctx context.Context, filePath string) (string, error) {
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return "", ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
-
-	filePathBuffer := stringToRustBuffer(filePath)
-
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_file_cost(cloned, filePathBuffer))
-	buf, err := pollRustBufferFuture(ctx, futureHandle)
-	if err != nil {
-		return "", err
-	}
-
-	return stringFromRustBuffer(buf), nil
+	ctx, cancel := c.withTimeout(ctx, opQuote, "FileCost")
+	defer cancel()
+
+	return retryCall(ctx, c, func(ctx context.Context) (string, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return "", err
+		}
+		defer c.release()
+
+		filePathBuffer := stringToRustBuffer(filePath)
+
+		consumeClones(cloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_file_cost(cloned, filePathBuffer))
+		buf, err := pollRustBufferFuture(ctx, futureHandle)
+		if err != nil {
+			return "", err
+		}
+
+		return stringFromRustBuffer(buf), nil
+	})
 }
 
 // ========== Chunk Operations ==========
 
+// ChunkPutResult represents the result of storing a chunk.
+type ChunkPutResult struct {
+	// The cost paid in tokens
+	Cost string
+	// The address where the chunk was stored
+	Address *ChunkAddress
+}
+
 // ChunkPut uploads a chunk to the network.
-// Returns the chunk address.
-func (c *Client) ChunkPut(ctx context.Context, data []byte, payment *PaymentOption) (*ChunkAddress, error) {
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
+// Returns a ChunkPutResult with the cost and chunk address.
+func (c *Client) ChunkPut(ctx context.Context, data []byte, payment *PaymentOption) (*ChunkPutResult, error) {
+	ctx, cancel := c.withTimeout(ctx, opWrite, "ChunkPut")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	dataBuffer := toRustBuffer(data)
 	paymentBuffer := getPaymentBuffer(payment)
 
+	consumeClones(cloned)
 	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_chunk_put(cloned, dataBuffer, paymentBuffer))
-	ptr, err := pollPointerFuture(ctx, futureHandle)
+	buf, err := pollRustBufferFuture(ctx, futureHandle)
 	if err != nil {
 		return nil, err
 	}
 
-	return newChunkAddress(ptr), nil
+	return decodeChunkPutResult(fromRustBufferRaw(buf, true))
 }
 
 // ChunkGet retrieves a chunk from the network.
@@ -470,26 +679,31 @@ func (c *Client) ChunkGet(ctx context.Context, address *ChunkAddress) (*Chunk, e
 		return nil, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	ctx, cancel := c.withTimeout(ctx, opRead, "ChunkGet")
+	defer cancel()
 
-	addressCloned := address.CloneHandle()
-	if addressCloned == nil {
-		return nil, ErrDisposed
-	}
+	return retryCall(ctx, c, func(ctx context.Context) (*Chunk, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return nil, err
+		}
+		defer c.release()
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_chunk_get(cloned, addressCloned))
-	ptr, err := pollPointerFuture(ctx, futureHandle)
-	if err != nil {
-		return nil, err
-	}
+		addressCloned := address.CloneHandle()
+		if addressCloned == nil {
+			c.freeClone(cloned)
+			return nil, ErrDisposed
+		}
 
-	return newChunk(ptr), nil
+		consumeClones(cloned, addressCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_chunk_get(cloned, addressCloned))
+		ptr, err := pollPointerFuture(ctx, futureHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		return newChunk(ptr), nil
+	})
 }
 
 // ========== Pointer Operations ==========
@@ -500,50 +714,64 @@ func (c *Client) PointerGet(ctx context.Context, address *PointerAddress) (*Netw
 		return nil, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	ctx, cancel := c.withTimeout(ctx, opRead, "PointerGet")
+	defer cancel()
 
-	addressCloned := address.CloneHandle()
-	if addressCloned == nil {
-		return nil, ErrDisposed
-	}
+	return retryCall(ctx, c, func(ctx context.Context) (*NetworkPointer, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return nil, err
+		}
+		defer c.release()
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_pointer_get(cloned, addressCloned))
-	ptr, err := pollPointerFuture(ctx, futureHandle)
-	if err != nil {
-		return nil, err
-	}
+		addressCloned := address.CloneHandle()
+		if addressCloned == nil {
+			c.freeClone(cloned)
+			return nil, ErrDisposed
+		}
 
-	return newNetworkPointer(ptr), nil
+		consumeClones(cloned, addressCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_pointer_get(cloned, addressCloned))
+		ptr, err := pollPointerFuture(ctx, futureHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		return newNetworkPointer(ptr), nil
+	})
 }
 
 // PointerPut stores a pointer on the network.
-func (c *Client) PointerPut(ctx context.Context, pointer *NetworkPointer, payment *PaymentOption) error {
+// Returns the address where the pointer was stored.
+func (c *Client) PointerPut(ctx context.Context, pointer *NetworkPointer, payment *PaymentOption) (*PointerAddress, error) {
 	if pointer == nil {
-		return ErrNilPointer
+		return nil, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return ErrDisposed
+	ctx, cancel := c.withTimeout(ctx, opWrite, "PointerPut")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	pointerCloned := pointer.CloneHandle()
 	if pointerCloned == nil {
-		return ErrDisposed
+		c.freeClone(cloned)
+		return nil, ErrDisposed
 	}
 	paymentBuffer := getPaymentBuffer(payment)
 
+	consumeClones(cloned, pointerCloned)
 	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_pointer_put(cloned, pointerCloned, paymentBuffer))
-	return pollVoidFuture(ctx, futureHandle)
+	ptr, err := pollPointerFuture(ctx, futureHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPointerAddress(ptr), nil
 }
 
 // ========== GraphEntry Operations ==========
@@ -554,50 +782,71 @@ func (c *Client) GraphEntryGet(ctx context.Context, address *GraphEntryAddress)
 		return nil, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	ctx, cancel := c.withTimeout(ctx, opRead, "GraphEntryGet")
+	defer cancel()
 
-	addressCloned := address.CloneHandle()
-	if addressCloned == nil {
-		return nil, ErrDisposed
-	}
+	return retryCall(ctx, c, func(ctx context.Context) (*GraphEntry, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return nil, err
+		}
+		defer c.release()
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_graph_entry_get(cloned, addressCloned))
-	ptr, err := pollPointerFuture(ctx, futureHandle)
-	if err != nil {
-		return nil, err
-	}
+		addressCloned := address.CloneHandle()
+		if addressCloned == nil {
+			c.freeClone(cloned)
+			return nil, ErrDisposed
+		}
 
-	return newGraphEntry(ptr), nil
+		consumeClones(cloned, addressCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_graph_entry_get(cloned, addressCloned))
+		ptr, err := pollPointerFuture(ctx, futureHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		return newGraphEntry(ptr), nil
+	})
+}
+
+// GraphEntryPutResult represents the result of storing a graph entry.
+type GraphEntryPutResult struct {
+	// The cost paid in tokens
+	Cost string
+	// The address where the graph entry was stored
+	Address *GraphEntryAddress
 }
 
 // GraphEntryPut stores a graph entry on the network.
-func (c *Client) GraphEntryPut(ctx context.Context, entry *GraphEntry, payment *PaymentOption) error {
+func (c *Client) GraphEntryPut(ctx context.Context, entry *GraphEntry, payment *PaymentOption) (*GraphEntryPutResult, error) {
 	if entry == nil {
-		return ErrNilPointer
+		return nil, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return ErrDisposed
+	ctx, cancel := c.withTimeout(ctx, opWrite, "GraphEntryPut")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	entryCloned := entry.CloneHandle()
 	if entryCloned == nil {
-		return ErrDisposed
+		c.freeClone(cloned)
+		return nil, ErrDisposed
 	}
 	paymentBuffer := getPaymentBuffer(payment)
 
+	consumeClones(cloned, entryCloned)
 	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_graph_entry_put(cloned, entryCloned, paymentBuffer))
-	return pollVoidFuture(ctx, futureHandle)
+	buf, err := pollRustBufferFuture(ctx, futureHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeGraphEntryPutResult(fromRustBufferRaw(buf, true))
 }
 
 // ========== Scratchpad Operations ==========
@@ -608,50 +857,64 @@ func (c *Client) ScratchpadGet(ctx context.Context, address *ScratchpadAddress)
 		return nil, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	ctx, cancel := c.withTimeout(ctx, opRead, "ScratchpadGet")
+	defer cancel()
 
-	addressCloned := address.CloneHandle()
-	if addressCloned == nil {
-		return nil, ErrDisposed
-	}
+	return retryCall(ctx, c, func(ctx context.Context) (*Scratchpad, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return nil, err
+		}
+		defer c.release()
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_scratchpad_get(cloned, addressCloned))
-	ptr, err := pollPointerFuture(ctx, futureHandle)
-	if err != nil {
-		return nil, err
-	}
+		addressCloned := address.CloneHandle()
+		if addressCloned == nil {
+			c.freeClone(cloned)
+			return nil, ErrDisposed
+		}
 
-	return newScratchpad(ptr), nil
+		consumeClones(cloned, addressCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_scratchpad_get(cloned, addressCloned))
+		ptr, err := pollPointerFuture(ctx, futureHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		return newScratchpad(ptr), nil
+	})
 }
 
 // ScratchpadPut stores a scratchpad on the network.
-func (c *Client) ScratchpadPut(ctx context.Context, scratchpad *Scratchpad, payment *PaymentOption) error {
+// Returns a ScratchpadCreateResult with the cost and scratchpad address.
+func (c *Client) ScratchpadPut(ctx context.Context, scratchpad *Scratchpad, payment *PaymentOption) (*ScratchpadCreateResult, error) {
 	if scratchpad == nil {
-		return ErrNilPointer
+		return nil, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return ErrDisposed
+	ctx, cancel := c.withTimeout(ctx, opWrite, "ScratchpadPut")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	scratchpadCloned := scratchpad.CloneHandle()
 	if scratchpadCloned == nil {
-		return ErrDisposed
+		c.freeClone(cloned)
+		return nil, ErrDisposed
 	}
 	paymentBuffer := getPaymentBuffer(payment)
 
+	consumeClones(cloned, scratchpadCloned)
 	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_scratchpad_put(cloned, scratchpadCloned, paymentBuffer))
-	return pollVoidFuture(ctx, futureHandle)
+	buf, err := pollRustBufferFuture(ctx, futureHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeScratchpadCreateResult(fromRustBufferRaw(buf, true))
 }
 
 // ========== Register Operations ==========
@@ -662,139 +925,299 @@ func (c *Client) RegisterGet(ctx context.Context, address *RegisterAddress) ([]b
 		return nil, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	ctx, cancel := c.withTimeout(ctx, opRead, "RegisterGet")
+	defer cancel()
 
-	addressCloned := address.CloneHandle()
-	if addressCloned == nil {
-		return nil, ErrDisposed
-	}
+	return retryCall(ctx, c, func(ctx context.Context) ([]byte, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return nil, err
+		}
+		defer c.release()
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_register_get(cloned, addressCloned))
-	buf, err := pollRustBufferFuture(ctx, futureHandle)
-	if err != nil {
-		return nil, err
-	}
+		addressCloned := address.CloneHandle()
+		if addressCloned == nil {
+			c.freeClone(cloned)
+			return nil, ErrDisposed
+		}
+
+		consumeClones(cloned, addressCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_register_get(cloned, addressCloned))
+		buf, err := pollRustBufferFuture(ctx, futureHandle)
+		if err != nil {
+			return nil, err
+		}
 
-	return fromRustBuffer(buf, true), nil
+		return fromRustBuffer(buf, true), nil
+	})
+}
+
+// RegisterCreateResult represents the result of creating a register.
+type RegisterCreateResult struct {
+	// The cost paid in tokens
+	Cost string
+	// The address where the register was stored
+	Address *RegisterAddress
 }
 
 // RegisterCreate creates a new register on the network.
-func (c *Client) RegisterCreate(ctx context.Context, owner *DerivedSecretKey, value []byte, payment *PaymentOption) (*RegisterAddress, error) {
+func (c *Client) RegisterCreate(ctx context.Context, owner *SecretKey, value []byte, payment *PaymentOption) (*RegisterCreateResult, error) {
 	if owner == nil {
 		return nil, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
+	ctx, cancel := c.withTimeout(ctx, opWrite, "RegisterCreate")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	ownerCloned := owner.CloneHandle()
 	if ownerCloned == nil {
+		c.freeClone(cloned)
 		return nil, ErrDisposed
 	}
 	valueBuffer := toRustBuffer(value)
 	paymentBuffer := getPaymentBuffer(payment)
 
+	consumeClones(cloned, ownerCloned)
 	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_register_create(cloned, ownerCloned, valueBuffer, paymentBuffer))
-	ptr, err := pollPointerFuture(ctx, futureHandle)
+	buf, err := pollRustBufferFuture(ctx, futureHandle)
 	if err != nil {
 		return nil, err
 	}
 
-	return newRegisterAddress(ptr), nil
+	return decodeRegisterCreateResult(fromRustBufferRaw(buf, true))
 }
 
 // RegisterUpdate updates an existing register on the network.
-func (c *Client) RegisterUpdate(ctx context.Context, owner *DerivedSecretKey, value []byte, payment *PaymentOption) error {
+// owner must be the key the register was created with. Returns the cost in tokens.
+func (c *Client) RegisterUpdate(ctx context.Context, owner *SecretKey, value []byte, payment *PaymentOption) (string, error) {
 	if owner == nil {
-		return ErrNilPointer
+		return "", ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return ErrDisposed
+	ctx, cancel := c.withTimeout(ctx, opWrite, "RegisterUpdate")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return "", err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	ownerCloned := owner.CloneHandle()
 	if ownerCloned == nil {
-		return ErrDisposed
+		c.freeClone(cloned)
+		return "", ErrDisposed
 	}
 	valueBuffer := toRustBuffer(value)
 	paymentBuffer := getPaymentBuffer(payment)
 
+	consumeClones(cloned, ownerCloned)
 	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_register_update(cloned, ownerCloned, valueBuffer, paymentBuffer))
-	return pollVoidFuture(ctx, futureHandle)
-}
+	buf, err := pollRustBufferFuture(ctx, futureHandle)
+	if err != nil {
+		return "", err
+	}
 
-// ========== Vault Operations ==========
+	return stringFromRustBuffer(buf), nil
+}
 
-// VaultGetUserData retrieves user data from a vault.
-func (c *Client) VaultGetUserData(ctx context.Context, secretKey *VaultSecretKey) (*UserData, error) {
-	if secretKey == nil {
+// RegisterHistory returns an iterator over every value the register has held,
+// from the root entry to the latest one. Nothing is fetched until the first
+// call to Next or All; each call to Next fetches a single value.
+func (c *Client) RegisterHistory(ctx context.Context, address *RegisterAddress) (*RegisterHistory, error) {
+	if address == nil {
 		return nil, ErrNilPointer
 	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
-	secretKeyCloned := secretKey.CloneHandle()
-	if secretKeyCloned == nil {
+	addressCloned := address.CloneHandle()
+	if addressCloned == nil {
+		c.freeClone(cloned)
 		return nil, ErrDisposed
 	}
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_vault_get_user_data(cloned, secretKeyCloned))
-	ptr, err := pollPointerFuture(ctx, futureHandle)
-	if err != nil {
+	var status C.RustCallStatus
+	consumeClones(cloned, addressCloned)
+	handle := C.uniffi_ant_ffi_fn_method_client_register_history(cloned, addressCloned, &status)
+	if err := checkStatus(&status, "RegisterHistory", liftClientError); err != nil {
 		return nil, err
 	}
 
-	return newUserData(ptr), nil
+	return newRegisterHistory(c, handle), nil
+}
+
+// ========== Vault Operations ==========
+
+// VaultGetUserData retrieves user data from a vault.
+func (c *Client) VaultGetUserData(ctx context.Context, secretKey *VaultSecretKey) (*UserData, error) {
+	if secretKey == nil {
+		return nil, ErrNilPointer
+	}
+
+	ctx, cancel := c.withTimeout(ctx, opRead, "VaultGetUserData")
+	defer cancel()
+
+	return retryCall(ctx, c, func(ctx context.Context) (*UserData, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return nil, err
+		}
+		defer c.release()
+
+		secretKeyCloned := secretKey.CloneHandle()
+		if secretKeyCloned == nil {
+			c.freeClone(cloned)
+			return nil, ErrDisposed
+		}
+
+		consumeClones(cloned, secretKeyCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_vault_get_user_data(cloned, secretKeyCloned))
+		ptr, err := pollPointerFuture(ctx, futureHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		return newUserData(ptr), nil
+	})
 }
 
 // VaultPutUserData stores user data in a vault.
-func (c *Client) VaultPutUserData(ctx context.Context, secretKey *VaultSecretKey, payment *PaymentOption, userData *UserData) error {
+// Returns the cost in tokens.
+func (c *Client) VaultPutUserData(ctx context.Context, secretKey *VaultSecretKey, payment *PaymentOption, userData *UserData) (string, error) {
 	if secretKey == nil || userData == nil {
-		return ErrNilPointer
+		return "", ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return ErrDisposed
+	ctx, cancel := c.withTimeout(ctx, opWrite, "VaultPutUserData")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return "", err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	secretKeyCloned := secretKey.CloneHandle()
 	if secretKeyCloned == nil {
-		return ErrDisposed
+		c.freeClone(cloned)
+		return "", ErrDisposed
 	}
 	userDataCloned := userData.CloneHandle()
 	if userDataCloned == nil {
-		return ErrDisposed
+		c.freeClone(cloned)
+		consumeClones(secretKeyCloned)
+		newVaultSecretKey(secretKeyCloned).Free()
+		return "", ErrDisposed
 	}
 	paymentBuffer := getPaymentBuffer(payment)
 
+	consumeClones(cloned, secretKeyCloned, userDataCloned)
 	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_vault_put_user_data(cloned, secretKeyCloned, paymentBuffer, userDataCloned))
-	return pollVoidFuture(ctx, futureHandle)
+	buf, err := pollRustBufferFuture(ctx, futureHandle)
+	if err != nil {
+		return "", err
+	}
+
+	return stringFromRustBuffer(buf), nil
+}
+
+// VaultGetResult represents raw data fetched from a vault.
+type VaultGetResult struct {
+	// The decrypted vault data
+	Data []byte
+	// The application-specific content type identifier
+	ContentType uint64
+}
+
+// VaultGet fetches and decrypts raw data from a vault.
+func (c *Client) VaultGet(ctx context.Context, secretKey *VaultSecretKey) (*VaultGetResult, error) {
+	if secretKey == nil {
+		return nil, ErrNilPointer
+	}
+
+	ctx, cancel := c.withTimeout(ctx, opRead, "VaultGet")
+	defer cancel()
+
+	return retryCall(ctx, c, func(ctx context.Context) (*VaultGetResult, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return nil, err
+		}
+		defer c.release()
+
+		secretKeyCloned := secretKey.CloneHandle()
+		if secretKeyCloned == nil {
+			c.freeClone(cloned)
+			return nil, ErrDisposed
+		}
+
+		consumeClones(cloned, secretKeyCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_vault_get(cloned, secretKeyCloned))
+		buf, err := pollRustBufferFuture(ctx, futureHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		// Deserialize VaultGetResult record (data: Vec<u8>, content_type: u64)
+		rawBytes := fromRustBufferRaw(buf, true)
+		reader := NewUniFFIReader(rawBytes)
+		data := reader.ReadBytes()
+		contentType := reader.ReadUint64()
+
+		return &VaultGetResult{
+			Data:        data,
+			ContentType: contentType,
+		}, nil
+	})
+}
+
+// VaultPut stores raw data in a vault under an application-specific content type.
+// The vault is expanded by paying for more space when needed.
+// Returns the cost of the operation.
+func (c *Client) VaultPut(ctx context.Context, secretKey *VaultSecretKey, payment *PaymentOption, data []byte, contentType uint64) (string, error) {
+	if secretKey == nil {
+		return "", ErrNilPointer
+	}
+
+	ctx, cancel := c.withTimeout(ctx, opWrite, "VaultPut")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return "", err
+	}
+	defer c.release()
+
+	secretKeyCloned := secretKey.CloneHandle()
+	if secretKeyCloned == nil {
+		c.freeClone(cloned)
+		return "", ErrDisposed
+	}
+	dataBuffer := toRustBuffer(data)
+	paymentBuffer := getPaymentBuffer(payment)
+
+	consumeClones(cloned, secretKeyCloned)
+	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_vault_put(cloned, dataBuffer, paymentBuffer, secretKeyCloned, C.uint64_t(contentType)))
+	buf, err := pollRustBufferFuture(ctx, futureHandle)
+	if err != nil {
+		return "", err
+	}
+
+	return stringFromRustBuffer(buf), nil
 }
 
 // ========== Archive Operations ==========
@@ -805,56 +1228,167 @@ func (c *Client) ArchiveGetPublic(ctx context.Context, address *ArchiveAddress)
 		return nil, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
+	ctx, cancel := c.withTimeout(ctx, opRead, "ArchiveGetPublic")
+	defer cancel()
+
+	return retryCall(ctx, c, func(ctx context.Context) (*PublicArchive, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return nil, err
+		}
+		defer c.release()
+
+		addressCloned := address.CloneHandle()
+		if addressCloned == nil {
+			c.freeClone(cloned)
+			return nil, ErrDisposed
+		}
+
+		consumeClones(cloned, addressCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_archive_get_public(cloned, addressCloned))
+		ptr, err := pollPointerFuture(ctx, futureHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		return newPublicArchive(ptr), nil
+	})
+}
+
+// PublicArchivePutResult represents the result of uploading a public archive.
+type PublicArchivePutResult struct {
+	// The cost paid for the upload in tokens
+	Cost string
+	// The address where the archive was stored
+	Address *ArchiveAddress
+}
+
+// ArchivePutPublic stores a public archive on the network.
+// Returns a PublicArchivePutResult with the cost and archive address.
+func (c *Client) ArchivePutPublic(ctx context.Context, archive *PublicArchive, payment *PaymentOption) (*PublicArchivePutResult, error) {
+	if archive == nil {
+		return nil, ErrNilPointer
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
 
-	addressCloned := address.CloneHandle()
-	if addressCloned == nil {
+	ctx, cancel := c.withTimeout(ctx, opWrite, "ArchivePutPublic")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer c.release()
+
+	archiveCloned := archive.CloneHandle()
+	if archiveCloned == nil {
+		c.freeClone(cloned)
 		return nil, ErrDisposed
 	}
+	paymentBuffer := getPaymentBuffer(payment)
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_archive_get_public(cloned, addressCloned))
-	ptr, err := pollPointerFuture(ctx, futureHandle)
+	consumeClones(cloned, archiveCloned)
+	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_archive_put_public(cloned, archiveCloned, paymentBuffer))
+	buf, err := pollRustBufferFuture(ctx, futureHandle)
 	if err != nil {
 		return nil, err
 	}
 
-	return newPublicArchive(ptr), nil
+	return decodePublicArchivePutResult(fromRustBufferRaw(buf, true))
 }
 
-// ArchivePutPublic stores a public archive on the network.
-// Returns the archive address.
-func (c *Client) ArchivePutPublic(ctx context.Context, archive *PublicArchive, payment *PaymentOption) (*ArchiveAddress, error) {
+// PrivateArchivePutResult represents the result of uploading a private archive.
+type PrivateArchivePutResult struct {
+	// The cost paid for the upload in tokens
+	Cost string
+	// The data map to retrieve the archive
+	DataMap *PrivateArchiveDataMap
+}
+
+// ArchiveGet retrieves a private archive from the network.
+func (c *Client) ArchiveGet(ctx context.Context, dataMap *PrivateArchiveDataMap) (*PrivateArchive, error) {
+	if dataMap == nil {
+		return nil, ErrNilPointer
+	}
+
+	ctx, cancel := c.withTimeout(ctx, opRead, "ArchiveGet")
+	defer cancel()
+
+	return retryCall(ctx, c, func(ctx context.Context) (*PrivateArchive, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return nil, err
+		}
+		defer c.release()
+
+		dataMapChunk, err := dataMap.toDataMapChunk()
+		if err != nil {
+			return nil, err
+		}
+		defer dataMapChunk.Free()
+
+		dataMapCloned := dataMapChunk.CloneHandle()
+		if dataMapCloned == nil {
+			c.freeClone(cloned)
+			return nil, ErrDisposed
+		}
+
+		consumeClones(cloned, dataMapCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_archive_get(cloned, dataMapCloned))
+		ptr, err := pollPointerFuture(ctx, futureHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		return newPrivateArchive(ptr), nil
+	})
+}
+
+// ArchivePut stores a private archive on the network.
+// Returns a PrivateArchivePutResult with the cost and data map.
+func (c *Client) ArchivePut(ctx context.Context, archive *PrivateArchive, payment *PaymentOption) (*PrivateArchivePutResult, error) {
 	if archive == nil {
 		return nil, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
+	ctx, cancel := c.withTimeout(ctx, opWrite, "ArchivePut")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	archiveCloned := archive.CloneHandle()
 	if archiveCloned == nil {
+		c.freeClone(cloned)
 		return nil, ErrDisposed
 	}
 	paymentBuffer := getPaymentBuffer(payment)
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_archive_put_public(cloned, archiveCloned, paymentBuffer))
-	ptr, err := pollPointerFuture(ctx, futureHandle)
+	consumeClones(cloned, archiveCloned)
+	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_archive_put(cloned, archiveCloned, paymentBuffer))
+	buf, err := pollRustBufferFuture(ctx, futureHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deserialize PrivateArchivePutResult record (cost: String, data_map: Arc<DataMapChunk>)
+	cost, dataMapChunk, err := decodeCostAndHandle(fromRustBufferRaw(buf, true), "PrivateArchivePutResult", newDataMapChunk)
 	if err != nil {
 		return nil, err
 	}
+	defer dataMapChunk.Free()
 
-	return newArchiveAddress(ptr), nil
+	dataMap, err := privateArchiveDataMapFromDataMapChunk(dataMapChunk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateArchivePutResult{
+		Cost:    cost,
+		DataMap: dataMap,
+	}, nil
 }
 
 // ========== Cost Methods ==========
@@ -865,26 +1399,31 @@ func (c *Client) ChunkCost(ctx context.Context, address *ChunkAddress) (string,
 		return "", ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return "", ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	ctx, cancel := c.withTimeout(ctx, opQuote, "ChunkCost")
+	defer cancel()
 
-	addressCloned := address.CloneHandle()
-	if addressCloned == nil {
-		return "", ErrDisposed
-	}
+	return retryCall(ctx, c, func(ctx context.Context) (string, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return "", err
+		}
+		defer c.release()
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_chunk_cost(cloned, addressCloned))
-	buf, err := pollRustBufferFuture(ctx, futureHandle)
-	if err != nil {
-		return "", err
-	}
+		addressCloned := address.CloneHandle()
+		if addressCloned == nil {
+			c.freeClone(cloned)
+			return "", ErrDisposed
+		}
 
-	return stringFromRustBuffer(buf), nil
+		consumeClones(cloned, addressCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_chunk_cost(cloned, addressCloned))
+		buf, err := pollRustBufferFuture(ctx, futureHandle)
+		if err != nil {
+			return "", err
+		}
+
+		return stringFromRustBuffer(buf), nil
+	})
 }
 
 // PointerCost calculates the cost to create a pointer for a given public key.
@@ -893,26 +1432,31 @@ func (c *Client) PointerCost(ctx context.Context, key *PublicKey) (string, error
 		return "", ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return "", ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	ctx, cancel := c.withTimeout(ctx, opQuote, "PointerCost")
+	defer cancel()
 
-	keyCloned := key.CloneHandle()
-	if keyCloned == nil {
-		return "", ErrDisposed
-	}
+	return retryCall(ctx, c, func(ctx context.Context) (string, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return "", err
+		}
+		defer c.release()
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_pointer_cost(cloned, keyCloned))
-	buf, err := pollRustBufferFuture(ctx, futureHandle)
-	if err != nil {
-		return "", err
-	}
+		keyCloned := key.CloneHandle()
+		if keyCloned == nil {
+			c.freeClone(cloned)
+			return "", ErrDisposed
+		}
 
-	return stringFromRustBuffer(buf), nil
+		consumeClones(cloned, keyCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_pointer_cost(cloned, keyCloned))
+		buf, err := pollRustBufferFuture(ctx, futureHandle)
+		if err != nil {
+			return "", err
+		}
+
+		return stringFromRustBuffer(buf), nil
+	})
 }
 
 // ScratchpadCost calculates the cost to create a scratchpad for a given public key.
@@ -921,26 +1465,31 @@ func (c *Client) ScratchpadCost(ctx context.Context, publicKey *PublicKey) (stri
 		return "", ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return "", ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	ctx, cancel := c.withTimeout(ctx, opQuote, "ScratchpadCost")
+	defer cancel()
 
-	keyCloned := publicKey.CloneHandle()
-	if keyCloned == nil {
-		return "", ErrDisposed
-	}
+	return retryCall(ctx, c, func(ctx context.Context) (string, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return "", err
+		}
+		defer c.release()
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_scratchpad_cost(cloned, keyCloned))
-	buf, err := pollRustBufferFuture(ctx, futureHandle)
-	if err != nil {
-		return "", err
-	}
+		keyCloned := publicKey.CloneHandle()
+		if keyCloned == nil {
+			c.freeClone(cloned)
+			return "", ErrDisposed
+		}
 
-	return stringFromRustBuffer(buf), nil
+		consumeClones(cloned, keyCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_scratchpad_cost(cloned, keyCloned))
+		buf, err := pollRustBufferFuture(ctx, futureHandle)
+		if err != nil {
+			return "", err
+		}
+
+		return stringFromRustBuffer(buf), nil
+	})
 }
 
 // RegisterCost calculates the cost to create a register for a given owner.
@@ -949,26 +1498,31 @@ func (c *Client) RegisterCost(ctx context.Context, owner *PublicKey) (string, er
 		return "", ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return "", ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	ctx, cancel := c.withTimeout(ctx, opQuote, "RegisterCost")
+	defer cancel()
 
-	ownerCloned := owner.CloneHandle()
-	if ownerCloned == nil {
-		return "", ErrDisposed
-	}
+	return retryCall(ctx, c, func(ctx context.Context) (string, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return "", err
+		}
+		defer c.release()
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_register_cost(cloned, ownerCloned))
-	buf, err := pollRustBufferFuture(ctx, futureHandle)
-	if err != nil {
-		return "", err
-	}
+		ownerCloned := owner.CloneHandle()
+		if ownerCloned == nil {
+			c.freeClone(cloned)
+			return "", ErrDisposed
+		}
 
-	return stringFromRustBuffer(buf), nil
+		consumeClones(cloned, ownerCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_register_cost(cloned, ownerCloned))
+		buf, err := pollRustBufferFuture(ctx, futureHandle)
+		if err != nil {
+			return "", err
+		}
+
+		return stringFromRustBuffer(buf), nil
+	})
 }
 
 // VaultCost calculates the cost to create a vault with a given maximum size.
@@ -977,26 +1531,31 @@ func (c *Client) VaultCost(ctx context.Context, key *VaultSecretKey, maxSize uin
 		return "", ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return "", ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	ctx, cancel := c.withTimeout(ctx, opQuote, "VaultCost")
+	defer cancel()
 
-	keyCloned := key.CloneHandle()
-	if keyCloned == nil {
-		return "", ErrDisposed
-	}
+	return retryCall(ctx, c, func(ctx context.Context) (string, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return "", err
+		}
+		defer c.release()
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_vault_cost(cloned, keyCloned, C.uint64_t(maxSize)))
-	buf, err := pollRustBufferFuture(ctx, futureHandle)
-	if err != nil {
-		return "", err
-	}
+		keyCloned := key.CloneHandle()
+		if keyCloned == nil {
+			c.freeClone(cloned)
+			return "", ErrDisposed
+		}
 
-	return stringFromRustBuffer(buf), nil
+		consumeClones(cloned, keyCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_vault_cost(cloned, keyCloned, C.uint64_t(maxSize)))
+		buf, err := pollRustBufferFuture(ctx, futureHandle)
+		if err != nil {
+			return "", err
+		}
+
+		return stringFromRustBuffer(buf), nil
+	})
 }
 
 // GraphEntryCost calculates the cost to create a graph entry for a given public key.
@@ -1005,26 +1564,31 @@ func (c *Client) GraphEntryCost(ctx context.Context, key *PublicKey) (string, er
 		return "", ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return "", ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	ctx, cancel := c.withTimeout(ctx, opQuote, "GraphEntryCost")
+	defer cancel()
 
-	keyCloned := key.CloneHandle()
-	if keyCloned == nil {
-		return "", ErrDisposed
-	}
+	return retryCall(ctx, c, func(ctx context.Context) (string, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return "", err
+		}
+		defer c.release()
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_graph_entry_cost(cloned, keyCloned))
-	buf, err := pollRustBufferFuture(ctx, futureHandle)
-	if err != nil {
-		return "", err
-	}
+		keyCloned := key.CloneHandle()
+		if keyCloned == nil {
+			c.freeClone(cloned)
+			return "", ErrDisposed
+		}
 
-	return stringFromRustBuffer(buf), nil
+		consumeClones(cloned, keyCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_graph_entry_cost(cloned, keyCloned))
+		buf, err := pollRustBufferFuture(ctx, futureHandle)
+		if err != nil {
+			return "", err
+		}
+
+		return stringFromRustBuffer(buf), nil
+	})
 }
 
 // ArchiveCost calculates the cost to store an archive on the network.
@@ -1033,90 +1597,165 @@ func (c *Client) ArchiveCost(ctx context.Context, archive *PublicArchive) (strin
 		return "", ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return "", ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	ctx, cancel := c.withTimeout(ctx, opQuote, "ArchiveCost")
+	defer cancel()
 
-	archiveCloned := archive.CloneHandle()
-	if archiveCloned == nil {
-		return "", ErrDisposed
-	}
+	return retryCall(ctx, c, func(ctx context.Context) (string, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return "", err
+		}
+		defer c.release()
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_archive_cost(cloned, archiveCloned))
-	buf, err := pollRustBufferFuture(ctx, futureHandle)
-	if err != nil {
-		return "", err
-	}
+		archiveCloned := archive.CloneHandle()
+		if archiveCloned == nil {
+			c.freeClone(cloned)
+			return "", ErrDisposed
+		}
 
-	return stringFromRustBuffer(buf), nil
+		consumeClones(cloned, archiveCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_archive_cost(cloned, archiveCloned))
+		buf, err := pollRustBufferFuture(ctx, futureHandle)
+		if err != nil {
+			return "", err
+		}
+
+		return stringFromRustBuffer(buf), nil
+	})
 }
 
 // ========== Pointer Additional Operations ==========
 
+// PointerCreateResult represents the result of creating a pointer.
+type PointerCreateResult struct {
+	// The cost paid in tokens
+	Cost string
+	// The address where the pointer was stored
+	Address *PointerAddress
+}
+
 // PointerCreate creates a new pointer pointing to a target address.
-func (c *Client) PointerCreate(ctx context.Context, owner *DerivedSecretKey, target *PointerTarget, payment *PaymentOption) (*PointerAddress, error) {
+func (c *Client) PointerCreate(ctx context.Context, owner *SecretKey, target *PointerTarget, payment *PaymentOption) (*PointerCreateResult, error) {
 	if owner == nil || target == nil {
 		return nil, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
+	ctx, cancel := c.withTimeout(ctx, opWrite, "PointerCreate")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	ownerCloned := owner.CloneHandle()
 	if ownerCloned == nil {
+		c.freeClone(cloned)
 		return nil, ErrDisposed
 	}
 	targetCloned := target.CloneHandle()
 	if targetCloned == nil {
+		c.freeClone(cloned)
+		consumeClones(ownerCloned)
+		newSecretKey(ownerCloned).Free()
 		return nil, ErrDisposed
 	}
 	paymentBuffer := getPaymentBuffer(payment)
 
+	consumeClones(cloned, ownerCloned, targetCloned)
 	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_pointer_create(cloned, ownerCloned, targetCloned, paymentBuffer))
-	ptr, err := pollPointerFuture(ctx, futureHandle)
+	buf, err := pollRustBufferFuture(ctx, futureHandle)
 	if err != nil {
 		return nil, err
 	}
 
-	return newPointerAddress(ptr), nil
+	return decodePointerCreateResult(fromRustBufferRaw(buf, true))
 }
 
 // PointerUpdate updates an existing pointer to point to a new target.
-func (c *Client) PointerUpdate(ctx context.Context, owner *DerivedSecretKey, target *PointerTarget, payment *PaymentOption) error {
+// owner must be the key the pointer was created with. The update is free, as
+// the pointer was paid for when it was created.
+func (c *Client) PointerUpdate(ctx context.Context, owner *SecretKey, target *PointerTarget) error {
 	if owner == nil || target == nil {
 		return ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return ErrDisposed
+	ctx, cancel := c.withTimeout(ctx, opWrite, "PointerUpdate")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	ownerCloned := owner.CloneHandle()
 	if ownerCloned == nil {
+		c.freeClone(cloned)
 		return ErrDisposed
 	}
 	targetCloned := target.CloneHandle()
 	if targetCloned == nil {
+		c.freeClone(cloned)
+		consumeClones(ownerCloned)
+		newSecretKey(ownerCloned).Free()
 		return ErrDisposed
 	}
-	paymentBuffer := getPaymentBuffer(payment)
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_pointer_update(cloned, ownerCloned, targetCloned, paymentBuffer))
-	_, err := pollRustBufferFuture(ctx, futureHandle)
-	return err
+	consumeClones(cloned, ownerCloned, targetCloned)
+	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_pointer_update(cloned, ownerCloned, targetCloned))
+	return pollVoidFuture(ctx, futureHandle)
+}
+
+// PointerUpdateFrom updates a pointer starting from a previously fetched copy,
+// skipping the network lookup of the current version.
+// Returns the new pointer, which can be passed to the next update.
+func (c *Client) PointerUpdateFrom(ctx context.Context, current *NetworkPointer, owner *SecretKey, target *PointerTarget) (*NetworkPointer, error) {
+	if current == nil || owner == nil || target == nil {
+		return nil, ErrNilPointer
+	}
+
+	ctx, cancel := c.withTimeout(ctx, opWrite, "PointerUpdateFrom")
+	defer cancel()
+
+	return retryCall(ctx, c, func(ctx context.Context) (*NetworkPointer, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return nil, err
+		}
+		defer c.release()
+
+		currentCloned := current.CloneHandle()
+		if currentCloned == nil {
+			c.freeClone(cloned)
+			return nil, ErrDisposed
+		}
+		ownerCloned := owner.CloneHandle()
+		if ownerCloned == nil {
+			c.freeClone(cloned)
+			consumeClones(currentCloned)
+			newNetworkPointer(currentCloned).Free()
+			return nil, ErrDisposed
+		}
+		targetCloned := target.CloneHandle()
+		if targetCloned == nil {
+			c.freeClone(cloned)
+			consumeClones(currentCloned, ownerCloned)
+			newNetworkPointer(currentCloned).Free()
+			newSecretKey(ownerCloned).Free()
+			return nil, ErrDisposed
+		}
+
+		consumeClones(cloned, currentCloned, ownerCloned, targetCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_pointer_update_from(cloned, currentCloned, ownerCloned, targetCloned))
+		ptr, err := pollPointerFuture(ctx, futureHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		return newNetworkPointer(ptr), nil
+	})
 }
 
 // PointerCheckExistence checks if a pointer exists at the given address.
@@ -1125,28 +1764,33 @@ func (c *Client) PointerCheckExistence(ctx context.Context, address *PointerAddr
 		return false, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return false, ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
-
-	addressCloned := address.CloneHandle()
-	if addressCloned == nil {
-		return false, ErrDisposed
-	}
-
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_pointer_check_existence(cloned, addressCloned))
-	buf, err := pollRustBufferFuture(ctx, futureHandle)
-	if err != nil {
-		return false, err
-	}
-
-	// Boolean is serialized as a single byte: 0 = false, 1 = true
-	data := fromRustBuffer(buf, true)
-	return len(data) > 0 && data[0] != 0, nil
+	ctx, cancel := c.withTimeout(ctx, opRead, "PointerCheckExistence")
+	defer cancel()
+
+	return retryCall(ctx, c, func(ctx context.Context) (bool, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return false, err
+		}
+		defer c.release()
+
+		addressCloned := address.CloneHandle()
+		if addressCloned == nil {
+			c.freeClone(cloned)
+			return false, ErrDisposed
+		}
+
+		consumeClones(cloned, addressCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_pointer_check_existence(cloned, addressCloned))
+		buf, err := pollRustBufferFuture(ctx, futureHandle)
+		if err != nil {
+			return false, err
+		}
+
+		// Boolean is serialized as a single byte: 0 = false, 1 = true
+		data := fromRustBuffer(buf, true)
+		return len(data) > 0 && data[0] != 0, nil
+	})
 }
 
 // ========== Scratchpad Additional Operations ==========
@@ -1157,112 +1801,208 @@ func (c *Client) ScratchpadGetFromPublicKey(ctx context.Context, publicKey *Publ
 		return nil, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	ctx, cancel := c.withTimeout(ctx, opRead, "ScratchpadGetFromPublicKey")
+	defer cancel()
 
-	keyCloned := publicKey.CloneHandle()
-	if keyCloned == nil {
-		return nil, ErrDisposed
-	}
+	return retryCall(ctx, c, func(ctx context.Context) (*Scratchpad, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return nil, err
+		}
+		defer c.release()
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_scratchpad_get_from_public_key(cloned, keyCloned))
-	ptr, err := pollPointerFuture(ctx, futureHandle)
-	if err != nil {
-		return nil, err
-	}
+		keyCloned := publicKey.CloneHandle()
+		if keyCloned == nil {
+			c.freeClone(cloned)
+			return nil, ErrDisposed
+		}
 
-	return newScratchpad(ptr), nil
+		consumeClones(cloned, keyCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_scratchpad_get_from_public_key(cloned, keyCloned))
+		ptr, err := pollPointerFuture(ctx, futureHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		return newScratchpad(ptr), nil
+	})
+}
+
+// ScratchpadCreateResult represents the result of creating a scratchpad.
+type ScratchpadCreateResult struct {
+	// The cost paid in tokens
+	Cost string
+	// The address where the scratchpad was stored
+	Address *ScratchpadAddress
 }
 
 // ScratchpadCreate creates a new scratchpad with initial data.
-func (c *Client) ScratchpadCreate(ctx context.Context, owner *DerivedSecretKey, contentType uint64, initialData []byte, payment *PaymentOption) (*ScratchpadAddress, error) {
+func (c *Client) ScratchpadCreate(ctx context.Context, owner *SecretKey, contentType uint64, initialData []byte, payment *PaymentOption) (*ScratchpadCreateResult, error) {
 	if owner == nil {
 		return nil, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
+	ctx, cancel := c.withTimeout(ctx, opWrite, "ScratchpadCreate")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	ownerCloned := owner.CloneHandle()
 	if ownerCloned == nil {
+		c.freeClone(cloned)
 		return nil, ErrDisposed
 	}
 	dataBuffer := toRustBuffer(initialData)
 	paymentBuffer := getPaymentBuffer(payment)
 
+	consumeClones(cloned, ownerCloned)
 	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_scratchpad_create(cloned, ownerCloned, C.uint64_t(contentType), dataBuffer, paymentBuffer))
-	ptr, err := pollPointerFuture(ctx, futureHandle)
+	buf, err := pollRustBufferFuture(ctx, futureHandle)
 	if err != nil {
 		return nil, err
 	}
 
-	return newScratchpadAddress(ptr), nil
+	return decodeScratchpadCreateResult(fromRustBufferRaw(buf, true))
 }
 
 // ScratchpadUpdate updates an existing scratchpad with new data.
-func (c *Client) ScratchpadUpdate(ctx context.Context, owner *DerivedSecretKey, contentType uint64, data []byte, payment *PaymentOption) error {
+// owner must be the key the scratchpad was created with. The update is free, as
+// the scratchpad was paid for when it was created.
+func (c *Client) ScratchpadUpdate(ctx context.Context, owner *SecretKey, contentType uint64, data []byte) error {
 	if owner == nil {
 		return ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return ErrDisposed
+	ctx, cancel := c.withTimeout(ctx, opWrite, "ScratchpadUpdate")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	ownerCloned := owner.CloneHandle()
 	if ownerCloned == nil {
+		c.freeClone(cloned)
 		return ErrDisposed
 	}
 	dataBuffer := toRustBuffer(data)
-	paymentBuffer := getPaymentBuffer(payment)
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_scratchpad_update(cloned, ownerCloned, C.uint64_t(contentType), dataBuffer, paymentBuffer))
-	_, err := pollRustBufferFuture(ctx, futureHandle)
-	return err
+	consumeClones(cloned, ownerCloned)
+	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_scratchpad_update(cloned, ownerCloned, C.uint64_t(contentType), dataBuffer))
+	return pollVoidFuture(ctx, futureHandle)
 }
 
-// ScratchpadCheckExistence checks if a scratchpad exists at the given address.
-func (c *Client) ScratchpadCheckExistence(ctx context.Context, address *ScratchpadAddress) (bool, error) {
-	if address == nil {
-		return false, ErrNilPointer
+// ScratchpadUpdateFrom updates a scratchpad starting from a previously fetched copy,
+// skipping the network lookup of the current version.
+// Returns the new scratchpad, which can be passed to the next update.
+func (c *Client) ScratchpadUpdateFrom(ctx context.Context, current *Scratchpad, owner *SecretKey, contentType uint64, data []byte) (*Scratchpad, error) {
+	if current == nil || owner == nil {
+		return nil, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return false, ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	ctx, cancel := c.withTimeout(ctx, opWrite, "ScratchpadUpdateFrom")
+	defer cancel()
+
+	return retryCall(ctx, c, func(ctx context.Context) (*Scratchpad, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return nil, err
+		}
+		defer c.release()
+
+		currentCloned := current.CloneHandle()
+		if currentCloned == nil {
+			c.freeClone(cloned)
+			return nil, ErrDisposed
+		}
+		ownerCloned := owner.CloneHandle()
+		if ownerCloned == nil {
+			c.freeClone(cloned)
+			consumeClones(currentCloned)
+			newScratchpad(currentCloned).Free()
+			return nil, ErrDisposed
+		}
+		dataBuffer := toRustBuffer(data)
+
+		consumeClones(cloned, currentCloned, ownerCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_scratchpad_update_from(cloned, currentCloned, ownerCloned, C.uint64_t(contentType), dataBuffer))
+		ptr, err := pollPointerFuture(ctx, futureHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		return newScratchpad(ptr), nil
+	})
+}
 
-	addressCloned := address.CloneHandle()
-	if addressCloned == nil {
-		return false, ErrDisposed
+// ScratchpadPutUpdate stores an already updated scratchpad without fetching
+// the current version first. The update is free as the scratchpad was already paid for.
+func (c *Client) ScratchpadPutUpdate(ctx context.Context, scratchpad *Scratchpad) error {
+	if scratchpad == nil {
+		return ErrNilPointer
 	}
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_scratchpad_check_existence(cloned, addressCloned))
-	buf, err := pollRustBufferFuture(ctx, futureHandle)
-	if err != nil {
-		return false, err
+	ctx, cancel := c.withTimeout(ctx, opWrite, "ScratchpadPutUpdate")
+	defer cancel()
+
+	return retryVoidCall(ctx, c, func(ctx context.Context) error {
+		cloned, err := c.acquire()
+		if err != nil {
+			return err
+		}
+		defer c.release()
+
+		scratchpadCloned := scratchpad.CloneHandle()
+		if scratchpadCloned == nil {
+			c.freeClone(cloned)
+			return ErrDisposed
+		}
+
+		consumeClones(cloned, scratchpadCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_scratchpad_put_update(cloned, scratchpadCloned))
+		return pollVoidFuture(ctx, futureHandle)
+	})
+}
+
+// ScratchpadCheckExistence checks if a scratchpad exists at the given address.
+func (c *Client) ScratchpadCheckExistence(ctx context.Context, address *ScratchpadAddress) (bool, error) {
+	if address == nil {
+		return false, ErrNilPointer
 	}
 
-	// Boolean is serialized as a single byte: 0 = false, 1 = true
-	data := fromRustBuffer(buf, true)
-	return len(data) > 0 && data[0] != 0, nil
+	ctx, cancel := c.withTimeout(ctx, opRead, "ScratchpadCheckExistence")
+	defer cancel()
+
+	return retryCall(ctx, c, func(ctx context.Context) (bool, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return false, err
+		}
+		defer c.release()
+
+		addressCloned := address.CloneHandle()
+		if addressCloned == nil {
+			c.freeClone(cloned)
+			return false, ErrDisposed
+		}
+
+		consumeClones(cloned, addressCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_scratchpad_check_existence(cloned, addressCloned))
+		buf, err := pollRustBufferFuture(ctx, futureHandle)
+		if err != nil {
+			return false, err
+		}
+
+		// Boolean is serialized as a single byte: 0 = false, 1 = true
+		data := fromRustBuffer(buf, true)
+		return len(data) > 0 && data[0] != 0, nil
+	})
 }
 
 // ========== GraphEntry Additional Operations ==========
@@ -1273,101 +2013,202 @@ func (c *Client) GraphEntryCheckExistence(ctx context.Context, address *GraphEnt
 		return false, ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return false, ErrDisposed
+	ctx, cancel := c.withTimeout(ctx, opRead, "GraphEntryCheckExistence")
+	defer cancel()
+
+	return retryCall(ctx, c, func(ctx context.Context) (bool, error) {
+		cloned, err := c.acquire()
+		if err != nil {
+			return false, err
+		}
+		defer c.release()
+
+		addressCloned := address.CloneHandle()
+		if addressCloned == nil {
+			c.freeClone(cloned)
+			return false, ErrDisposed
+		}
+
+		consumeClones(cloned, addressCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_graph_entry_check_existence(cloned, addressCloned))
+		buf, err := pollRustBufferFuture(ctx, futureHandle)
+		if err != nil {
+			return false, err
+		}
+
+		// Boolean is serialized as a single byte: 0 = false, 1 = true
+		data := fromRustBuffer(buf, true)
+		return len(data) > 0 && data[0] != 0, nil
+	})
+}
+
+// ========== Directory Operations ==========
+
+// DirUploadResult represents the result of uploading a directory as a private archive.
+type DirUploadResult struct {
+	// The cost paid for the upload in tokens
+	Cost string
+	// The data map to retrieve the directory archive
+	DataMap *PrivateArchiveDataMap
+}
+
+// DirUpload uploads a directory to the network (private), paying with wallet.
+// Returns a DirUploadResult with the cost and the archive data map.
+// opts may set a ProgressFunc; see TransferOptions. ProgressFileStarted and
+// ProgressFileFinished are not reported, as the directory is uploaded in one call.
+func (c *Client) DirUpload(ctx context.Context, path string, wallet *Wallet, opts ...TransferOptions) (*DirUploadResult, error) {
+	if wallet == nil {
+		return nil, ErrNilPointer
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
 
-	addressCloned := address.CloneHandle()
-	if addressCloned == nil {
-		return false, ErrDisposed
+	ctx, cancel := c.withTimeout(ctx, opTransfer, "DirUpload")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
 	}
+	defer c.release()
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_graph_entry_check_existence(cloned, addressCloned))
+	walletCloned := wallet.CloneHandle()
+	if walletCloned == nil {
+		c.freeClone(cloned)
+		return nil, ErrDisposed
+	}
+	pathBuffer := stringToRustBuffer(path)
+
+	var futureHandle uint64
+	if progress := newProgressReporter(opts); progress != nil {
+		defer progress.close()
+		consumeClones(cloned, walletCloned)
+		futureHandle = uint64(C.uniffi_ant_ffi_fn_method_client_dir_upload_with_progress(cloned, pathBuffer, walletCloned, progress.lower()))
+	} else {
+		consumeClones(cloned, walletCloned)
+		futureHandle = uint64(C.uniffi_ant_ffi_fn_method_client_dir_upload(cloned, pathBuffer, walletCloned))
+	}
 	buf, err := pollRustBufferFuture(ctx, futureHandle)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	// Boolean is serialized as a single byte: 0 = false, 1 = true
-	data := fromRustBuffer(buf, true)
-	return len(data) > 0 && data[0] != 0, nil
+	return decodeDirUploadResult(fromRustBufferRaw(buf, true))
 }
 
-// ========== Directory Operations ==========
+// DirContentUploadResult represents the result of uploading directory content.
+type DirContentUploadResult struct {
+	// The cost paid for the upload in tokens
+	Cost string
+	// The archive referencing the uploaded files (not yet uploaded itself)
+	Archive *PrivateArchive
+}
 
-// DirUpload uploads a directory to the network (private).
-// Returns a DataMapChunk for accessing the directory.
-func (c *Client) DirUpload(ctx context.Context, path string, payment *PaymentOption) (*PrivateArchiveDataMap, error) {
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
+// DirContentUpload uploads the files of a directory without uploading the archive itself.
+// The returned archive can be edited with AddFile/RenameFile and then stored with ArchivePut.
+func (c *Client) DirContentUpload(ctx context.Context, path string, payment *PaymentOption) (*DirContentUploadResult, error) {
+	ctx, cancel := c.withTimeout(ctx, opTransfer, "DirContentUpload")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
+	defer c.release()
 
 	pathBuffer := stringToRustBuffer(path)
 	paymentBuffer := getPaymentBuffer(payment)
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_dir_upload(cloned, pathBuffer, paymentBuffer))
-	ptr, err := pollPointerFuture(ctx, futureHandle)
+	consumeClones(cloned)
+	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_dir_content_upload(cloned, pathBuffer, paymentBuffer))
+	buf, err := pollRustBufferFuture(ctx, futureHandle)
 	if err != nil {
 		return nil, err
 	}
 
-	return newPrivateArchiveDataMap(ptr), nil
+	return decodeDirContentUploadResult(fromRustBufferRaw(buf, true))
 }
 
-// DirUploadPublic uploads a directory to the network (public).
-// Returns the archive address.
-func (c *Client) DirUploadPublic(ctx context.Context, path string, payment *PaymentOption) (*ArchiveAddress, error) {
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return nil, ErrDisposed
+// DirUploadPublicResult represents the result of uploading a directory as a public archive.
+type DirUploadPublicResult struct {
+	// The cost paid for the upload in tokens
+	Cost string
+	// The address where the directory archive was stored
+	Address *ArchiveAddress
+}
+
+// DirUploadPublic uploads a directory to the network (public), paying with wallet.
+// Returns a DirUploadPublicResult with the cost and archive address.
+func (c *Client) DirUploadPublic(ctx context.Context, path string, wallet *Wallet) (*DirUploadPublicResult, error) {
+	if wallet == nil {
+		return nil, ErrNilPointer
 	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
 
+	ctx, cancel := c.withTimeout(ctx, opTransfer, "DirUploadPublic")
+	defer cancel()
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer c.release()
+
+	walletCloned := wallet.CloneHandle()
+	if walletCloned == nil {
+		c.freeClone(cloned)
+		return nil, ErrDisposed
+	}
 	pathBuffer := stringToRustBuffer(path)
-	paymentBuffer := getPaymentBuffer(payment)
 
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_dir_upload_public(cloned, pathBuffer, paymentBuffer))
-	ptr, err := pollPointerFuture(ctx, futureHandle)
+	consumeClones(cloned, walletCloned)
+	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_dir_upload_public(cloned, pathBuffer, walletCloned))
+	buf, err := pollRustBufferFuture(ctx, futureHandle)
 	if err != nil {
 		return nil, err
 	}
 
-	return newArchiveAddress(ptr), nil
+	return decodeDirUploadPublicResult(fromRustBufferRaw(buf, true))
 }
 
 // DirDownload downloads a private directory from the network to a local path.
-func (c *Client) DirDownload(ctx context.Context, dataMap *PrivateArchiveDataMap, destPath string) error {
+// opts may set a ProgressFunc; see TransferOptions. A retried download reports
+// ProgressStarted again and counts from zero.
+func (c *Client) DirDownload(ctx context.Context, dataMap *PrivateArchiveDataMap, destPath string, opts ...TransferOptions) error {
 	if dataMap == nil {
 		return ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
-
-	dataMapCloned := dataMap.CloneHandle()
-	if dataMapCloned == nil {
-		return ErrDisposed
-	}
-	destPathBuffer := stringToRustBuffer(destPath)
-
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_dir_download(cloned, dataMapCloned, destPathBuffer))
-	err := pollVoidFuture(ctx, futureHandle)
-	return err
+	ctx, cancel := c.withTimeout(ctx, opTransfer, "DirDownload")
+	defer cancel()
+
+	progress := newProgressReporter(opts)
+	if progress != nil {
+		defer progress.close()
+	}
+
+	return retryVoidCall(ctx, c, func(ctx context.Context) error {
+		cloned, err := c.acquire()
+		if err != nil {
+			return err
+		}
+		defer c.release()
+
+		dataMapCloned := dataMap.CloneHandle()
+		if dataMapCloned == nil {
+			c.freeClone(cloned)
+			return ErrDisposed
+		}
+		destPathBuffer := stringToRustBuffer(destPath)
+
+		var futureHandle uint64
+		if progress != nil {
+			consumeClones(cloned, dataMapCloned)
+			futureHandle = uint64(C.uniffi_ant_ffi_fn_method_client_dir_download_with_progress(cloned, dataMapCloned, destPathBuffer, progress.lower()))
+		} else {
+			consumeClones(cloned, dataMapCloned)
+			futureHandle = uint64(C.uniffi_ant_ffi_fn_method_client_dir_download(cloned, dataMapCloned, destPathBuffer))
+		}
+		err = pollVoidFuture(ctx, futureHandle)
+		return err
+	})
 }
 
 // DirDownloadPublic downloads a public directory from the network to a local path.
@@ -1376,21 +2217,26 @@ func (c *Client) DirDownloadPublic(ctx context.Context, address *ArchiveAddress,
 		return ErrNilPointer
 	}
 
-	c.mu.Lock()
-	if c.freed {
-		c.mu.Unlock()
-		return ErrDisposed
-	}
-	cloned := c.cloneHandle()
-	c.mu.Unlock()
-
-	addressCloned := address.CloneHandle()
-	if addressCloned == nil {
-		return ErrDisposed
-	}
-	destPathBuffer := stringToRustBuffer(destPath)
-
-	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_dir_download_public(cloned, addressCloned, destPathBuffer))
-	err := pollVoidFuture(ctx, futureHandle)
-	return err
+	ctx, cancel := c.withTimeout(ctx, opTransfer, "DirDownloadPublic")
+	defer cancel()
+
+	return retryVoidCall(ctx, c, func(ctx context.Context) error {
+		cloned, err := c.acquire()
+		if err != nil {
+			return err
+		}
+		defer c.release()
+
+		addressCloned := address.CloneHandle()
+		if addressCloned == nil {
+			c.freeClone(cloned)
+			return ErrDisposed
+		}
+		destPathBuffer := stringToRustBuffer(destPath)
+
+		consumeClones(cloned, addressCloned)
+		futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_dir_download_public(cloned, addressCloned, destPathBuffer))
+		err = pollVoidFuture(ctx, futureHandle)
+		return err
+	})
 }
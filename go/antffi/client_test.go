@@ -0,0 +1,55 @@
+package antffi
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestUpdateFromNilArguments(t *testing.T) {
+	ctx := context.Background()
+	c := &Client{}
+
+	pointerCases := map[string]func() error{
+		"current": func() error { _, err := c.PointerUpdateFrom(ctx, nil, &SecretKey{}, &PointerTarget{}); return err },
+		"owner":   func() error { _, err := c.PointerUpdateFrom(ctx, &NetworkPointer{}, nil, &PointerTarget{}); return err },
+		"target":  func() error { _, err := c.PointerUpdateFrom(ctx, &NetworkPointer{}, &SecretKey{}, nil); return err },
+	}
+	for name, call := range pointerCases {
+		if err := call(); !errors.Is(err, ErrNilPointer) {
+			t.Errorf("PointerUpdateFrom with nil %s: expected ErrNilPointer, got %v", name, err)
+		}
+	}
+
+	if _, err := c.ScratchpadUpdateFrom(ctx, nil, &SecretKey{}, 0, nil); !errors.Is(err, ErrNilPointer) {
+		t.Errorf("ScratchpadUpdateFrom with nil current: expected ErrNilPointer, got %v", err)
+	}
+	if _, err := c.ScratchpadUpdateFrom(ctx, &Scratchpad{}, nil, 0, nil); !errors.Is(err, ErrNilPointer) {
+		t.Errorf("ScratchpadUpdateFrom with nil owner: expected ErrNilPointer, got %v", err)
+	}
+	if err := c.ScratchpadPutUpdate(ctx, nil); !errors.Is(err, ErrNilPointer) {
+		t.Errorf("ScratchpadPutUpdate: expected ErrNilPointer, got %v", err)
+	}
+	if _, err := c.PointerPut(ctx, nil, nil); !errors.Is(err, ErrNilPointer) {
+		t.Errorf("PointerPut: expected ErrNilPointer, got %v", err)
+	}
+}
+
+func TestUpdateFromClosedClient(t *testing.T) {
+	ctx := context.Background()
+	c := &Client{}
+	c.Close()
+
+	if _, err := c.PointerUpdateFrom(ctx, &NetworkPointer{}, &SecretKey{}, &PointerTarget{}); !errors.Is(err, ErrDisposed) {
+		t.Errorf("PointerUpdateFrom: expected ErrDisposed, got %v", err)
+	}
+	if _, err := c.ScratchpadUpdateFrom(ctx, &Scratchpad{}, &SecretKey{}, 0, nil); !errors.Is(err, ErrDisposed) {
+		t.Errorf("ScratchpadUpdateFrom: expected ErrDisposed, got %v", err)
+	}
+	if err := c.ScratchpadPutUpdate(ctx, &Scratchpad{}); !errors.Is(err, ErrDisposed) {
+		t.Errorf("ScratchpadPutUpdate: expected ErrDisposed, got %v", err)
+	}
+	if _, err := c.PointerPut(ctx, &NetworkPointer{}, nil); !errors.Is(err, ErrDisposed) {
+		t.Errorf("PointerPut: expected ErrDisposed, got %v", err)
+	}
+}
@@ -65,7 +65,7 @@ func ChunkMaxSize() (uint64, error) {
 	var status C.RustCallStatus
 	result := C.uniffi_ant_ffi_fn_func_chunk_max_size(&status)
 
-	if err := checkStatus(&status, "ChunkMaxSize"); err != nil {
+	if err := checkStatus(&status, "ChunkMaxSize", liftDataError); err != nil {
 		return 0, err
 	}
 
@@ -77,7 +77,7 @@ func ChunkMaxRawSize() (uint64, error) {
 	var status C.RustCallStatus
 	result := C.uniffi_ant_ffi_fn_func_chunk_max_raw_size(&status)
 
-	if err := checkStatus(&status, "ChunkMaxRawSize"); err != nil {
+	if err := checkStatus(&status, "ChunkMaxRawSize", liftDataError); err != nil {
 		return 0, err
 	}
 
@@ -98,7 +98,7 @@ func NewChunk(data []byte) (*Chunk, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_chunk_new(dataBuffer, &status)
 
-	if err := checkStatus(&status, "Chunk.New"); err != nil {
+	if err := checkStatus(&status, "Chunk.New", liftDataError); err != nil {
 		return nil, err
 	}
 
@@ -108,6 +108,7 @@ func NewChunk(data []byte) (*Chunk, error) {
 func newChunk(handle unsafe.Pointer) *Chunk {
 	c := &Chunk{handle: handle}
 	runtime.SetFinalizer(c, (*Chunk).Free)
+	trackHandle(unsafe.Pointer(c), "Chunk", c.handle)
 	return c
 }
 
@@ -121,9 +122,16 @@ func (c *Chunk) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_chunk(c.handle, &status)
+	untrackHandle(unsafe.Pointer(c))
 	c.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (c *Chunk) Close() error {
+	c.Free()
+	return nil
+}
+
 func (c *Chunk) Value() ([]byte, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -134,9 +142,10 @@ func (c *Chunk) Value() ([]byte, error) {
 
 	cloned := c.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_chunk_value(cloned, &status)
 
-	if err := checkStatus(&status, "Chunk.Value"); err != nil {
+	if err := checkStatus(&status, "Chunk.Value", liftDataError); err != nil {
 		return nil, err
 	}
 
@@ -153,9 +162,10 @@ func (c *Chunk) Address() (*ChunkAddress, error) {
 
 	cloned := c.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_method_chunk_address(cloned, &status)
 
-	if err := checkStatus(&status, "Chunk.Address"); err != nil {
+	if err := checkStatus(&status, "Chunk.Address", liftDataError); err != nil {
 		return nil, err
 	}
 
@@ -172,9 +182,10 @@ func (c *Chunk) NetworkAddress() (string, error) {
 
 	cloned := c.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_chunk_network_address(cloned, &status)
 
-	if err := checkStatus(&status, "Chunk.NetworkAddress"); err != nil {
+	if err := checkStatus(&status, "Chunk.NetworkAddress", liftDataError); err != nil {
 		return "", err
 	}
 
@@ -191,9 +202,10 @@ func (c *Chunk) Size() (uint64, error) {
 
 	cloned := c.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_chunk_size(cloned, &status)
 
-	if err := checkStatus(&status, "Chunk.Size"); err != nil {
+	if err := checkStatus(&status, "Chunk.Size", liftDataError); err != nil {
 		return 0, err
 	}
 
@@ -210,9 +222,10 @@ func (c *Chunk) IsTooBig() (bool, error) {
 
 	cloned := c.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_chunk_is_too_big(cloned, &status)
 
-	if err := checkStatus(&status, "Chunk.IsTooBig"); err != nil {
+	if err := checkStatus(&status, "Chunk.IsTooBig", liftDataError); err != nil {
 		return false, err
 	}
 
@@ -221,7 +234,7 @@ func (c *Chunk) IsTooBig() (bool, error) {
 
 func (c *Chunk) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_chunk(c.handle, &status)
+	return trackClone("Chunk", C.uniffi_ant_ffi_fn_clone_chunk(c.handle, &status))
 }
 
 func (c *Chunk) CloneHandle() unsafe.Pointer {
@@ -247,7 +260,7 @@ func NewChunkAddress(data []byte) (*ChunkAddress, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_chunkaddress_new(dataBuffer, &status)
 
-	if err := checkStatus(&status, "ChunkAddress.New"); err != nil {
+	if err := checkStatus(&status, "ChunkAddress.New", liftDataError); err != nil {
 		return nil, err
 	}
 
@@ -261,7 +274,7 @@ func ChunkAddressFromContent(data []byte) (*ChunkAddress, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_chunkaddress_from_content(dataBuffer, &status)
 
-	if err := checkStatus(&status, "ChunkAddress.FromContent"); err != nil {
+	if err := checkStatus(&status, "ChunkAddress.FromContent", liftDataError); err != nil {
 		return nil, err
 	}
 
@@ -275,7 +288,7 @@ func ChunkAddressFromHex(hex string) (*ChunkAddress, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_chunkaddress_from_hex(hexBuffer, &status)
 
-	if err := checkStatus(&status, "ChunkAddress.FromHex"); err != nil {
+	if err := checkStatus(&status, "ChunkAddress.FromHex", liftDataError); err != nil {
 		return nil, err
 	}
 
@@ -285,6 +298,7 @@ func ChunkAddressFromHex(hex string) (*ChunkAddress, error) {
 func newChunkAddress(handle unsafe.Pointer) *ChunkAddress {
 	ca := &ChunkAddress{handle: handle}
 	runtime.SetFinalizer(ca, (*ChunkAddress).Free)
+	trackHandle(unsafe.Pointer(ca), "ChunkAddress", ca.handle)
 	return ca
 }
 
@@ -298,9 +312,16 @@ func (ca *ChunkAddress) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_chunkaddress(ca.handle, &status)
+	untrackHandle(unsafe.Pointer(ca))
 	ca.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (ca *ChunkAddress) Close() error {
+	ca.Free()
+	return nil
+}
+
 func (ca *ChunkAddress) ToHex() (string, error) {
 	ca.mu.Lock()
 	defer ca.mu.Unlock()
@@ -311,9 +332,10 @@ func (ca *ChunkAddress) ToHex() (string, error) {
 
 	cloned := ca.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_chunkaddress_to_hex(cloned, &status)
 
-	if err := checkStatus(&status, "ChunkAddress.ToHex"); err != nil {
+	if err := checkStatus(&status, "ChunkAddress.ToHex", liftDataError); err != nil {
 		return "", err
 	}
 
@@ -330,9 +352,10 @@ func (ca *ChunkAddress) ToBytes() ([]byte, error) {
 
 	cloned := ca.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_chunkaddress_to_bytes(cloned, &status)
 
-	if err := checkStatus(&status, "ChunkAddress.ToBytes"); err != nil {
+	if err := checkStatus(&status, "ChunkAddress.ToBytes", liftDataError); err != nil {
 		return nil, err
 	}
 
@@ -341,7 +364,7 @@ func (ca *ChunkAddress) ToBytes() ([]byte, error) {
 
 func (ca *ChunkAddress) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_chunkaddress(ca.handle, &status)
+	return trackClone("ChunkAddress", C.uniffi_ant_ffi_fn_clone_chunkaddress(ca.handle, &status))
 }
 
 func (ca *ChunkAddress) CloneHandle() unsafe.Pointer {
@@ -367,7 +390,7 @@ func NewDataAddress(data []byte) (*DataAddress, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_dataaddress_new(dataBuffer, &status)
 
-	if err := checkStatus(&status, "DataAddress.New"); err != nil {
+	if err := checkStatus(&status, "DataAddress.New", liftDataError); err != nil {
 		return nil, err
 	}
 
@@ -381,7 +404,7 @@ func DataAddressFromHex(hex string) (*DataAddress, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_dataaddress_from_hex(hexBuffer, &status)
 
-	if err := checkStatus(&status, "DataAddress.FromHex"); err != nil {
+	if err := checkStatus(&status, "DataAddress.FromHex", liftDataError); err != nil {
 		return nil, err
 	}
 
@@ -391,6 +414,7 @@ func DataAddressFromHex(hex string) (*DataAddress, error) {
 func newDataAddress(handle unsafe.Pointer) *DataAddress {
 	da := &DataAddress{handle: handle}
 	runtime.SetFinalizer(da, (*DataAddress).Free)
+	trackHandle(unsafe.Pointer(da), "DataAddress", da.handle)
 	return da
 }
 
@@ -404,9 +428,16 @@ func (da *DataAddress) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_dataaddress(da.handle, &status)
+	untrackHandle(unsafe.Pointer(da))
 	da.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (da *DataAddress) Close() error {
+	da.Free()
+	return nil
+}
+
 func (da *DataAddress) ToHex() (string, error) {
 	da.mu.Lock()
 	defer da.mu.Unlock()
@@ -417,9 +448,10 @@ func (da *DataAddress) ToHex() (string, error) {
 
 	cloned := da.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_dataaddress_to_hex(cloned, &status)
 
-	if err := checkStatus(&status, "DataAddress.ToHex"); err != nil {
+	if err := checkStatus(&status, "DataAddress.ToHex", liftDataError); err != nil {
 		return "", err
 	}
 
@@ -436,9 +468,10 @@ func (da *DataAddress) ToBytes() ([]byte, error) {
 
 	cloned := da.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_dataaddress_to_bytes(cloned, &status)
 
-	if err := checkStatus(&status, "DataAddress.ToBytes"); err != nil {
+	if err := checkStatus(&status, "DataAddress.ToBytes", liftDataError); err != nil {
 		return nil, err
 	}
 
@@ -447,7 +480,7 @@ func (da *DataAddress) ToBytes() ([]byte, error) {
 
 func (da *DataAddress) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_dataaddress(da.handle, &status)
+	return trackClone("DataAddress", C.uniffi_ant_ffi_fn_clone_dataaddress(da.handle, &status))
 }
 
 func (da *DataAddress) CloneHandle() unsafe.Pointer {
@@ -473,7 +506,7 @@ func DataMapChunkFromHex(hex string) (*DataMapChunk, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_datamapchunk_from_hex(hexBuffer, &status)
 
-	if err := checkStatus(&status, "DataMapChunk.FromHex"); err != nil {
+	if err := checkStatus(&status, "DataMapChunk.FromHex", liftDataError); err != nil {
 		return nil, err
 	}
 
@@ -483,6 +516,7 @@ func DataMapChunkFromHex(hex string) (*DataMapChunk, error) {
 func newDataMapChunk(handle unsafe.Pointer) *DataMapChunk {
 	dmc := &DataMapChunk{handle: handle}
 	runtime.SetFinalizer(dmc, (*DataMapChunk).Free)
+	trackHandle(unsafe.Pointer(dmc), "DataMapChunk", dmc.handle)
 	return dmc
 }
 
@@ -496,9 +530,16 @@ func (dmc *DataMapChunk) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_datamapchunk(dmc.handle, &status)
+	untrackHandle(unsafe.Pointer(dmc))
 	dmc.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (dmc *DataMapChunk) Close() error {
+	dmc.Free()
+	return nil
+}
+
 func (dmc *DataMapChunk) ToHex() (string, error) {
 	dmc.mu.Lock()
 	defer dmc.mu.Unlock()
@@ -509,9 +550,10 @@ func (dmc *DataMapChunk) ToHex() (string, error) {
 
 	cloned := dmc.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_datamapchunk_to_hex(cloned, &status)
 
-	if err := checkStatus(&status, "DataMapChunk.ToHex"); err != nil {
+	if err := checkStatus(&status, "DataMapChunk.ToHex", liftDataError); err != nil {
 		return "", err
 	}
 
@@ -528,9 +570,10 @@ func (dmc *DataMapChunk) Address() (string, error) {
 
 	cloned := dmc.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_datamapchunk_address(cloned, &status)
 
-	if err := checkStatus(&status, "DataMapChunk.Address"); err != nil {
+	if err := checkStatus(&status, "DataMapChunk.Address", liftDataError); err != nil {
 		return "", err
 	}
 
@@ -539,7 +582,7 @@ func (dmc *DataMapChunk) Address() (string, error) {
 
 func (dmc *DataMapChunk) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_datamapchunk(dmc.handle, &status)
+	return trackClone("DataMapChunk", C.uniffi_ant_ffi_fn_clone_datamapchunk(dmc.handle, &status))
 }
 
 func (dmc *DataMapChunk) CloneHandle() unsafe.Pointer {
@@ -0,0 +1,175 @@
+package antffi
+
+import (
+	"log"
+	"os"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+)
+
+// Debug modes, enabled from the environment at startup or at runtime:
+//
+//	ANTFFI_DEBUG_HANDLES=1  record every live native handle (see LiveHandles)
+//	ANTFFI_TRACE_CGO=1      log every buffer crossing the cgo boundary
+//
+// Both add overhead and are meant for diagnosing leaks, not for production use.
+
+// HandleInfo describes a native handle owned by a live wrapper object.
+type HandleInfo struct {
+	// ID identifies the tracking record; it increases with each allocation.
+	ID uint64
+	// Type is the wrapper type, e.g. "SecretKey".
+	Type string
+	// Handle is the address of the Rust object.
+	Handle uintptr
+	// Created is when the wrapper was created.
+	Created time.Time
+	// Stack is the goroutine stack that created the wrapper.
+	Stack string
+	// Clone is set for a cloned handle that no FFI call has consumed yet,
+	// rather than one owned by a wrapper.
+	Clone bool
+}
+
+var (
+	handleTracking atomic.Bool
+	handleMu       sync.Mutex
+	handleSeq      uint64
+	liveHandles    = map[uintptr]HandleInfo{}   // keyed by wrapper address
+	liveClones     = map[uintptr][]HandleInfo{} // keyed by handle; clones share it
+	cloneCount     atomic.Int64                 // entries in liveClones
+
+	cgoTrace atomic.Pointer[log.Logger]
+)
+
+func init() {
+	if os.Getenv("ANTFFI_DEBUG_HANDLES") == "1" {
+		handleTracking.Store(true)
+	}
+	if os.Getenv("ANTFFI_TRACE_CGO") == "1" {
+		cgoTrace.Store(log.Default())
+	}
+}
+
+// EnableHandleTracking turns live handle tracking on or off.
+// Handles created while tracking is off are never reported.
+func EnableHandleTracking(enabled bool) {
+	handleTracking.Store(enabled)
+}
+
+// HandleTrackingEnabled reports whether live handle tracking is on.
+func HandleTrackingEnabled() bool {
+	return handleTracking.Load()
+}
+
+// LiveHandles returns the tracked handles whose wrappers have not been freed,
+// oldest first. It is empty unless handle tracking is enabled.
+//
+// It also reports the clones returned by the CloneHandle methods, and those
+// made internally for each FFI call, until they are passed to a consuming FFI
+// call or freed, so a clone dropped on an error path shows up as a leak.
+func LiveHandles() []HandleInfo {
+	handleMu.Lock()
+	defer handleMu.Unlock()
+
+	handles := make([]HandleInfo, 0, len(liveHandles))
+	for _, info := range liveHandles {
+		handles = append(handles, info)
+	}
+	for _, clones := range liveClones {
+		handles = append(handles, clones...)
+	}
+	sort.Slice(handles, func(i, j int) bool { return handles[i].ID < handles[j].ID })
+	return handles
+}
+
+// SetCgoTrace sets the logger that receives a line for every buffer crossing
+// the cgo boundary. A nil logger turns tracing off.
+func SetCgoTrace(logger *log.Logger) {
+	cgoTrace.Store(logger)
+}
+
+// trackHandle records a native handle owned by the wrapper at owner.
+func trackHandle(owner unsafe.Pointer, typeName string, handle unsafe.Pointer) {
+	if !handleTracking.Load() || handle == nil {
+		return
+	}
+
+	info := HandleInfo{
+		Type:    typeName,
+		Handle:  uintptr(handle),
+		Created: time.Now(),
+		Stack:   string(debug.Stack()),
+	}
+
+	handleMu.Lock()
+	handleSeq++
+	info.ID = handleSeq
+	liveHandles[uintptr(owner)] = info
+	handleMu.Unlock()
+}
+
+// untrackHandle forgets the handle owned by the wrapper at owner once it is freed.
+func untrackHandle(owner unsafe.Pointer) {
+	handleMu.Lock()
+	delete(liveHandles, uintptr(owner))
+	handleMu.Unlock()
+}
+
+// trackClone records a cloned handle of the named type and returns it.
+func trackClone(typeName string, handle unsafe.Pointer) unsafe.Pointer {
+	if !handleTracking.Load() || handle == nil {
+		return handle
+	}
+
+	info := HandleInfo{
+		Type:    typeName,
+		Handle:  uintptr(handle),
+		Created: time.Now(),
+		Stack:   string(debug.Stack()),
+		Clone:   true,
+	}
+
+	handleMu.Lock()
+	handleSeq++
+	info.ID = handleSeq
+	liveClones[uintptr(handle)] = append(liveClones[uintptr(handle)], info)
+	cloneCount.Add(1)
+	handleMu.Unlock()
+	return handle
+}
+
+// consumeClones forgets one tracked clone of each handle, once it has been
+// passed to an FFI call that takes ownership of it or freed. Clones of the
+// same object share a handle, so the most recent one is forgotten.
+func consumeClones(handles ...unsafe.Pointer) {
+	if cloneCount.Load() == 0 {
+		return
+	}
+
+	handleMu.Lock()
+	defer handleMu.Unlock()
+	for _, handle := range handles {
+		clones := liveClones[uintptr(handle)]
+		switch len(clones) {
+		case 0:
+			continue
+		case 1:
+			delete(liveClones, uintptr(handle))
+		default:
+			liveClones[uintptr(handle)] = clones[:len(clones)-1]
+		}
+		cloneCount.Add(-1)
+	}
+}
+
+// traceCgo logs a buffer of size bytes crossing the cgo boundary in op.
+func traceCgo(op string, size int) {
+	if logger := cgoTrace.Load(); logger != nil {
+		logger.Printf("antffi cgo: %s %d bytes", op, size)
+	}
+}
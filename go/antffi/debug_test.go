@@ -0,0 +1,144 @@
+package antffi
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+// trackedOwners stand in for wrapper objects. They are package-level so their
+// addresses stay fixed; a stack-allocated owner can move when the stack grows.
+var trackedOwners [3]int
+
+func TestTrackHandle(t *testing.T) {
+	EnableHandleTracking(true)
+	defer EnableHandleTracking(false)
+
+	first, second := &trackedOwners[0], &trackedOwners[1]
+	trackHandle(unsafe.Pointer(first), "SecretKey", unsafe.Pointer(first))
+	trackHandle(unsafe.Pointer(second), "Chunk", unsafe.Pointer(second))
+
+	live := LiveHandles()
+	if len(live) != 2 {
+		t.Fatalf("Expected 2 live handles, got %d", len(live))
+	}
+	if live[0].Type != "SecretKey" || live[1].Type != "Chunk" || live[0].ID >= live[1].ID {
+		t.Fatalf("Live handles not in allocation order: %+v", live)
+	}
+	if live[0].Handle != uintptr(unsafe.Pointer(first)) || !strings.Contains(live[0].Stack, "TestTrackHandle") {
+		t.Fatalf("Missing handle address or stack: %+v", live[0])
+	}
+
+	untrackHandle(unsafe.Pointer(first))
+	untrackHandle(unsafe.Pointer(second))
+	if n := len(LiveHandles()); n != 0 {
+		t.Fatalf("Expected no live handles after untrack, got %d", n)
+	}
+}
+
+func TestTrackHandleDisabled(t *testing.T) {
+	EnableHandleTracking(false)
+
+	trackHandle(unsafe.Pointer(&trackedOwners[2]), "SecretKey", nil)
+	if n := len(LiveHandles()); n != 0 {
+		t.Fatalf("Tracked %d handles while disabled", n)
+	}
+}
+
+func TestTraceCgo(t *testing.T) {
+	var out bytes.Buffer
+	SetCgoTrace(log.New(&out, "", 0))
+	traceCgo("toRustBuffer", 1024)
+	SetCgoTrace(nil)
+	traceCgo("fromRustBuffer", 1)
+
+	if got := out.String(); got != "antffi cgo: toRustBuffer 1024 bytes\n" {
+		t.Fatalf("Unexpected trace output: %q", got)
+	}
+}
+
+func TestTrackClone(t *testing.T) {
+	EnableHandleTracking(true)
+	defer EnableHandleTracking(false)
+
+	handle := unsafe.Pointer(&trackedOwners[2])
+	trackClone("SecretKey", handle)
+	trackClone("SecretKey", handle)
+
+	live := LiveHandles()
+	if len(live) != 2 || !live[0].Clone || live[0].Handle != uintptr(handle) || !strings.Contains(live[0].Stack, "TestTrackClone") {
+		t.Fatalf("Expected 2 tracked clones, got %+v", live)
+	}
+
+	consumeClones(handle)
+	if n := len(LiveHandles()); n != 1 {
+		t.Fatalf("Expected 1 clone after consuming one, got %d", n)
+	}
+	consumeClones(handle, handle)
+	if n := len(LiveHandles()); n != 0 {
+		t.Fatalf("Expected no clones after consuming both, got %d", n)
+	}
+}
+
+// liveCloneInfos returns the clones LiveHandles reports.
+func liveCloneInfos() []HandleInfo {
+	var clones []HandleInfo
+	for _, h := range LiveHandles() {
+		if h.Clone {
+			clones = append(clones, h)
+		}
+	}
+	return clones
+}
+
+func TestDisposedArgumentReleasesClones(t *testing.T) {
+	EnableHandleTracking(true)
+	defer EnableHandleTracking(false)
+
+	msk, err := NewMainSecretKeyRandom()
+	if err != nil {
+		t.Fatalf("NewMainSecretKeyRandom failed: %v", err)
+	}
+	defer msk.Free()
+	index, err := NewDerivationIndex()
+	if err != nil {
+		t.Fatalf("NewDerivationIndex failed: %v", err)
+	}
+	index.Free()
+
+	if _, err := msk.DeriveKey(index); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed, got %v", err)
+	}
+
+	key, err := NewSecretKey()
+	if err != nil {
+		t.Fatalf("NewSecretKey failed: %v", err)
+	}
+	defer key.Free()
+	chunk, err := NewChunk([]byte("target"))
+	if err != nil {
+		t.Fatalf("NewChunk failed: %v", err)
+	}
+	defer chunk.Free()
+	address, err := chunk.Address()
+	if err != nil {
+		t.Fatalf("Address failed: %v", err)
+	}
+	defer address.Free()
+	target, err := NewPointerTargetChunk(address)
+	if err != nil {
+		t.Fatalf("NewPointerTargetChunk failed: %v", err)
+	}
+	target.Free()
+
+	if _, err := NewNetworkPointer(key, 0, target); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed, got %v", err)
+	}
+
+	if clones := liveCloneInfos(); len(clones) != 0 {
+		t.Fatalf("Clones left unconsumed: %+v", clones)
+	}
+}
@@ -50,11 +50,51 @@ var (
 	// ErrWallet is returned when a wallet operation fails.
 	ErrWallet = errors.New("wallet operation failed")
 
+	// ErrTimeout is returned when an operation exceeds its default timeout.
+	ErrTimeout = errors.New("operation timed out")
+
+	// ErrNotReady is returned by Future.Result when the operation has not finished.
+	ErrNotReady = errors.New("future not ready")
+
 	// ErrCancelled is returned when an operation is cancelled.
 	ErrCancelled = errors.New("operation cancelled")
+
+	// ErrInvalidPeer is returned when a bootstrap peer is not a valid multiaddr.
+	ErrInvalidPeer = errors.New("invalid peer address")
+
+	// ErrInvalidSignature is returned when a signed record fails verification.
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrRustPanic is returned when the Rust library panics during a call.
+	ErrRustPanic = errors.New("rust panic")
+
+	// ErrMalformedResult is returned when a result from the FFI layer cannot be decoded.
+	ErrMalformedResult = errors.New("malformed FFI result")
+
+	// ErrData is returned when a data address or data map operation fails.
+	ErrData = errors.New("data operation failed")
+
+	// ErrArchive is returned when an archive operation fails.
+	ErrArchive = errors.New("archive operation failed")
+
+	// ErrPointer is returned when a pointer operation fails.
+	ErrPointer = errors.New("pointer operation failed")
+
+	// ErrScratchpad is returned when a scratchpad operation fails.
+	ErrScratchpad = errors.New("scratchpad operation failed")
+
+	// ErrRegister is returned when a register operation fails.
+	ErrRegister = errors.New("register operation failed")
+
+	// ErrGraphEntry is returned when a graph entry operation fails.
+	ErrGraphEntry = errors.New("graph entry operation failed")
+
+	// ErrVault is returned when a vault operation fails.
+	ErrVault = errors.New("vault operation failed")
 )
 
-// AntFFIError represents an error from the Rust FFI layer.
+// AntFFIError represents an error from the Rust FFI layer that does not map
+// onto one of the typed errors below.
 type AntFFIError struct {
 	Code    int8
 	Message string
@@ -68,120 +108,541 @@ func (e *AntFFIError) Error() string {
 	return fmt.Sprintf("ant_ffi error (code: %d)", e.Code)
 }
 
+// PanicError represents a panic inside the Rust library (status code 2).
+// It indicates a library bug rather than a network or input failure, so it
+// should not be retried. It matches ErrRustPanic.
+type PanicError struct {
+	Operation string
+	Message   string
+}
+
+func (e *PanicError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("rust panic in %s", e.Operation)
+	}
+	return fmt.Sprintf("rust panic in %s: %s", e.Operation, e.Message)
+}
+
+func (e *PanicError) Unwrap() error {
+	return ErrRustPanic
+}
+
+// RustCallStatus codes set by UniFFI scaffolding.
+const (
+	callStatusSuccess    int8 = 0
+	callStatusError      int8 = 1
+	callStatusUnexpected int8 = 2
+)
+
+// errorLifter converts a decoded Rust error enum variant into its typed Go error.
+// variant is the 1-based UniFFI variant index and reason is the variant's single
+// string field. It returns nil for an unknown variant.
+type errorLifter func(variant int32, reason string) error
+
 // checkStatus checks the RustCallStatus and returns an error if the call failed.
-func checkStatus(status *C.RustCallStatus, operation string) error {
-	if status.code == 0 {
+// When the call returned a Rust error enum (code 1), lift decodes it into the
+// typed error for that enum. lift may be nil for calls that cannot return one.
+func checkStatus(status *C.RustCallStatus, operation string, lift errorLifter) error {
+	if int8(status.code) == callStatusSuccess {
 		return nil
 	}
+	return classifyStatus(int8(status.code), fromRustBufferRaw(status.error_buf, true), operation, lift)
+}
 
-	// Extract error message from error_buf if present
-	var message string
-	if status.error_buf.len > 0 {
-		message = stringFromRustBuffer(status.error_buf)
+// classifyStatus turns a failed call's status code and error_buf contents into
+// a Go error: a typed error for declared errors, a *PanicError for panics.
+func classifyStatus(code int8, errorBuf []byte, operation string, lift errorLifter) error {
+	switch code {
+	case callStatusSuccess:
+		return nil
+	case callStatusError:
+		if lift != nil {
+			return liftError(errorBuf, operation, lift)
+		}
+	case callStatusUnexpected:
+		// The panic message is lowered as a bare UTF-8 string.
+		return &PanicError{Operation: operation, Message: string(errorBuf)}
 	}
 
+	message := string(errorBuf)
 	if message == "" {
 		message = fmt.Sprintf("%s failed", operation)
 	}
 
 	return &AntFFIError{
-		Code:    int8(status.code),
+		Code:    code,
 		Message: message,
 	}
 }
 
-// EncryptionError represents an encryption-specific error.
+// liftError decodes a serialized Rust error enum: a 4-byte big-endian variant
+// index followed by the variant's length-prefixed string field.
+func liftError(data []byte, operation string, lift errorLifter) error {
+	reader := NewUniFFIReader(data)
+	variant := reader.ReadInt32()
+	reason := reader.ReadString()
+
+	if len(data) != 4+4+len(reason) {
+		return fmt.Errorf("%s failed: %w: error has %d bytes", operation, ErrMalformedResult, len(data))
+	}
+
+	if err := lift(variant, reason); err != nil {
+		return err
+	}
+	return fmt.Errorf("%s failed: %w: unknown error variant %d", operation, ErrMalformedResult, variant)
+}
+
+// errorKindAt returns the kind for a 1-based UniFFI variant index.
+func errorKindAt[K any](kinds []K, variant int32) (K, bool) {
+	var zero K
+	if variant < 1 || int(variant) > len(kinds) {
+		return zero, false
+	}
+	return kinds[variant-1], true
+}
+
+// formatRustError formats a typed error as "<family> error: <kind>: <reason>".
+func formatRustError(family string, kind string, reason string) string {
+	if reason == "" {
+		return fmt.Sprintf("%s error: %s", family, kind)
+	}
+	return fmt.Sprintf("%s error: %s: %s", family, kind, reason)
+}
+
+// ClientErrorKind identifies a variant of the Rust ClientError enum.
+type ClientErrorKind string
+
+const (
+	ClientNetworkError         ClientErrorKind = "NetworkError"
+	ClientInitializationFailed ClientErrorKind = "InitializationFailed"
+	ClientInvalidAddress       ClientErrorKind = "InvalidAddress"
+	ClientInvalidInput         ClientErrorKind = "InvalidInput"
+)
+
+var clientErrorKinds = []ClientErrorKind{ClientNetworkError, ClientInitializationFailed, ClientInvalidAddress, ClientInvalidInput}
+
+// ClientError represents a client operation error.
+// It matches ErrClient, and also ErrNetwork when Kind is ClientNetworkError.
+type ClientError struct {
+	Kind   ClientErrorKind
+	Reason string
+}
+
+func (e *ClientError) Error() string {
+	return formatRustError("client", string(e.Kind), e.Reason)
+}
+
+func (e *ClientError) Is(target error) bool {
+	return target == ErrNetwork && e.Kind == ClientNetworkError
+}
+
+func (e *ClientError) Unwrap() error {
+	return ErrClient
+}
+
+func liftClientError(variant int32, reason string) error {
+	if kind, ok := errorKindAt(clientErrorKinds, variant); ok {
+		return &ClientError{Kind: kind, Reason: reason}
+	}
+	return nil
+}
+
+// WalletErrorKind identifies a variant of the Rust WalletError enum.
+type WalletErrorKind string
+
+const (
+	WalletCreationFailed     WalletErrorKind = "CreationFailed"
+	WalletBalanceCheckFailed WalletErrorKind = "BalanceCheckFailed"
+)
+
+var walletErrorKinds = []WalletErrorKind{WalletCreationFailed, WalletBalanceCheckFailed}
+
+// WalletError represents a wallet operation error. It matches ErrWallet.
+type WalletError struct {
+	Kind   WalletErrorKind
+	Reason string
+}
+
+func (e *WalletError) Error() string {
+	return formatRustError("wallet", string(e.Kind), e.Reason)
+}
+
+func (e *WalletError) Unwrap() error {
+	return ErrWallet
+}
+
+func liftWalletError(variant int32, reason string) error {
+	if kind, ok := errorKindAt(walletErrorKinds, variant); ok {
+		return &WalletError{Kind: kind, Reason: reason}
+	}
+	return nil
+}
+
+// NetworkErrorKind identifies a variant of the Rust NetworkError enum.
+type NetworkErrorKind string
+
+const (
+	NetworkCreationFailed NetworkErrorKind = "CreationFailed"
+)
+
+var networkErrorKinds = []NetworkErrorKind{NetworkCreationFailed}
+
+// NetworkError represents a network configuration error. It matches ErrNetwork.
+type NetworkError struct {
+	Kind   NetworkErrorKind
+	Reason string
+}
+
+func (e *NetworkError) Error() string {
+	return formatRustError("network", string(e.Kind), e.Reason)
+}
+
+func (e *NetworkError) Unwrap() error {
+	return ErrNetwork
+}
+
+func liftNetworkError(variant int32, reason string) error {
+	if kind, ok := errorKindAt(networkErrorKinds, variant); ok {
+		return &NetworkError{Kind: kind, Reason: reason}
+	}
+	return nil
+}
+
+// KeyErrorKind identifies a variant of the Rust KeyError enum.
+type KeyErrorKind string
+
+const (
+	KeyInvalidKey    KeyErrorKind = "InvalidKey"
+	KeyParsingFailed KeyErrorKind = "ParsingFailed"
+)
+
+var keyErrorKinds = []KeyErrorKind{KeyInvalidKey, KeyParsingFailed}
+
+// KeyError represents a key operation error. It matches ErrKey.
+type KeyError struct {
+	Kind   KeyErrorKind
+	Reason string
+}
+
+func (e *KeyError) Error() string {
+	return formatRustError("key", string(e.Kind), e.Reason)
+}
+
+func (e *KeyError) Unwrap() error {
+	return ErrKey
+}
+
+func liftKeyError(variant int32, reason string) error {
+	if kind, ok := errorKindAt(keyErrorKinds, variant); ok {
+		return &KeyError{Kind: kind, Reason: reason}
+	}
+	return nil
+}
+
+// EncryptionErrorKind identifies a variant of the Rust EncryptionError enum.
+type EncryptionErrorKind string
+
+const (
+	EncryptionFailed EncryptionErrorKind = "EncryptionFailed"
+)
+
+var encryptionErrorKinds = []EncryptionErrorKind{EncryptionFailed}
+
+// EncryptionError represents a self-encryption error. It matches ErrEncryption.
 type EncryptionError struct {
-	Wrapped error
+	Kind   EncryptionErrorKind
+	Reason string
 }
 
 func (e *EncryptionError) Error() string {
-	if e.Wrapped != nil {
-		return fmt.Sprintf("encryption error: %v", e.Wrapped)
-	}
-	return "encryption error"
+	return formatRustError("encryption", string(e.Kind), e.Reason)
 }
 
 func (e *EncryptionError) Unwrap() error {
-	return e.Wrapped
+	return ErrEncryption
 }
 
-// DecryptionError represents a decryption-specific error.
+func liftEncryptionError(variant int32, reason string) error {
+	if kind, ok := errorKindAt(encryptionErrorKinds, variant); ok {
+		return &EncryptionError{Kind: kind, Reason: reason}
+	}
+	return nil
+}
+
+// DecryptionError represents a self-encryption error returned while decrypting.
+// Rust reports it as an EncryptionError; it matches ErrDecryption.
 type DecryptionError struct {
-	Wrapped error
+	Kind   EncryptionErrorKind
+	Reason string
 }
 
 func (e *DecryptionError) Error() string {
-	if e.Wrapped != nil {
-		return fmt.Sprintf("decryption error: %v", e.Wrapped)
-	}
-	return "decryption error"
+	return formatRustError("decryption", string(e.Kind), e.Reason)
 }
 
 func (e *DecryptionError) Unwrap() error {
-	return e.Wrapped
+	return ErrDecryption
 }
 
-// KeyError represents a key operation error.
-type KeyError struct {
-	Wrapped error
+func liftDecryptionError(variant int32, reason string) error {
+	if kind, ok := errorKindAt(encryptionErrorKinds, variant); ok {
+		return &DecryptionError{Kind: kind, Reason: reason}
+	}
+	return nil
 }
 
-func (e *KeyError) Error() string {
-	if e.Wrapped != nil {
-		return fmt.Sprintf("key error: %v", e.Wrapped)
+// DataErrorKind identifies a variant of the Rust DataError enum.
+type DataErrorKind string
+
+const (
+	DataInvalidData   DataErrorKind = "InvalidData"
+	DataParsingFailed DataErrorKind = "ParsingFailed"
+)
+
+var dataErrorKinds = []DataErrorKind{DataInvalidData, DataParsingFailed}
+
+// DataError represents a data address or data map error. It matches ErrData.
+type DataError struct {
+	Kind   DataErrorKind
+	Reason string
+}
+
+func (e *DataError) Error() string {
+	return formatRustError("data", string(e.Kind), e.Reason)
+}
+
+func (e *DataError) Unwrap() error {
+	return ErrData
+}
+
+func liftDataError(variant int32, reason string) error {
+	if kind, ok := errorKindAt(dataErrorKinds, variant); ok {
+		return &DataError{Kind: kind, Reason: reason}
 	}
-	return "key error"
+	return nil
 }
 
-func (e *KeyError) Unwrap() error {
-	return e.Wrapped
+// ArchiveErrorKind identifies a variant of the Rust ArchiveError enum.
+type ArchiveErrorKind string
+
+const (
+	ArchiveInvalidArchive ArchiveErrorKind = "InvalidArchive"
+	ArchiveParsingFailed  ArchiveErrorKind = "ParsingFailed"
+	ArchiveFileNotFound   ArchiveErrorKind = "FileNotFound"
+)
+
+var archiveErrorKinds = []ArchiveErrorKind{ArchiveInvalidArchive, ArchiveParsingFailed, ArchiveFileNotFound}
+
+// ArchiveError represents an archive operation error. It matches ErrArchive.
+// For ArchiveFileNotFound, Reason holds the missing path.
+type ArchiveError struct {
+	Kind   ArchiveErrorKind
+	Reason string
 }
 
-// NetworkError represents a network operation error.
-type NetworkError struct {
-	Wrapped error
+func (e *ArchiveError) Error() string {
+	return formatRustError("archive", string(e.Kind), e.Reason)
 }
 
-func (e *NetworkError) Error() string {
-	if e.Wrapped != nil {
-		return fmt.Sprintf("network error: %v", e.Wrapped)
+func (e *ArchiveError) Unwrap() error {
+	return ErrArchive
+}
+
+func liftArchiveError(variant int32, reason string) error {
+	if kind, ok := errorKindAt(archiveErrorKinds, variant); ok {
+		return &ArchiveError{Kind: kind, Reason: reason}
 	}
-	return "network error"
+	return nil
 }
 
-func (e *NetworkError) Unwrap() error {
-	return e.Wrapped
+// PointerErrorKind identifies a variant of the Rust PointerError enum.
+type PointerErrorKind string
+
+const (
+	PointerInvalidPointer PointerErrorKind = "InvalidPointer"
+	PointerParsingFailed  PointerErrorKind = "ParsingFailed"
+)
+
+var pointerErrorKinds = []PointerErrorKind{PointerInvalidPointer, PointerParsingFailed}
+
+// PointerError represents a pointer operation error. It matches ErrPointer.
+type PointerError struct {
+	Kind   PointerErrorKind
+	Reason string
 }
 
-// ClientError represents a client operation error.
-type ClientError struct {
-	Wrapped error
+func (e *PointerError) Error() string {
+	return formatRustError("pointer", string(e.Kind), e.Reason)
 }
 
-func (e *ClientError) Error() string {
-	if e.Wrapped != nil {
-		return fmt.Sprintf("client error: %v", e.Wrapped)
+func (e *PointerError) Unwrap() error {
+	return ErrPointer
+}
+
+func liftPointerError(variant int32, reason string) error {
+	if kind, ok := errorKindAt(pointerErrorKinds, variant); ok {
+		return &PointerError{Kind: kind, Reason: reason}
 	}
-	return "client error"
+	return nil
 }
 
-func (e *ClientError) Unwrap() error {
-	return e.Wrapped
+// ScratchpadErrorKind identifies a variant of the Rust ScratchpadError enum.
+type ScratchpadErrorKind string
+
+const (
+	ScratchpadInvalidScratchpad ScratchpadErrorKind = "InvalidScratchpad"
+	ScratchpadParsingFailed     ScratchpadErrorKind = "ParsingFailed"
+	ScratchpadDecryptionFailed  ScratchpadErrorKind = "DecryptionFailed"
+)
+
+var scratchpadErrorKinds = []ScratchpadErrorKind{ScratchpadInvalidScratchpad, ScratchpadParsingFailed, ScratchpadDecryptionFailed}
+
+// ScratchpadError represents a scratchpad operation error. It matches
+// ErrScratchpad, and also ErrDecryption when Kind is ScratchpadDecryptionFailed.
+type ScratchpadError struct {
+	Kind   ScratchpadErrorKind
+	Reason string
 }
 
-// WalletError represents a wallet operation error.
-type WalletError struct {
-	Wrapped error
+func (e *ScratchpadError) Error() string {
+	return formatRustError("scratchpad", string(e.Kind), e.Reason)
 }
 
-func (e *WalletError) Error() string {
-	if e.Wrapped != nil {
-		return fmt.Sprintf("wallet error: %v", e.Wrapped)
+func (e *ScratchpadError) Is(target error) bool {
+	return target == ErrDecryption && e.Kind == ScratchpadDecryptionFailed
+}
+
+func (e *ScratchpadError) Unwrap() error {
+	return ErrScratchpad
+}
+
+func liftScratchpadError(variant int32, reason string) error {
+	if kind, ok := errorKindAt(scratchpadErrorKinds, variant); ok {
+		return &ScratchpadError{Kind: kind, Reason: reason}
 	}
-	return "wallet error"
+	return nil
 }
 
-func (e *WalletError) Unwrap() error {
-	return e.Wrapped
+// RegisterErrorKind identifies a variant of the Rust RegisterError enum.
+type RegisterErrorKind string
+
+const (
+	RegisterInvalidRegister RegisterErrorKind = "InvalidRegister"
+	RegisterParsingFailed   RegisterErrorKind = "ParsingFailed"
+)
+
+var registerErrorKinds = []RegisterErrorKind{RegisterInvalidRegister, RegisterParsingFailed}
+
+// RegisterError represents a register operation error. It matches ErrRegister.
+type RegisterError struct {
+	Kind   RegisterErrorKind
+	Reason string
+}
+
+func (e *RegisterError) Error() string {
+	return formatRustError("register", string(e.Kind), e.Reason)
+}
+
+func (e *RegisterError) Unwrap() error {
+	return ErrRegister
+}
+
+func liftRegisterError(variant int32, reason string) error {
+	if kind, ok := errorKindAt(registerErrorKinds, variant); ok {
+		return &RegisterError{Kind: kind, Reason: reason}
+	}
+	return nil
+}
+
+// GraphEntryErrorKind identifies a variant of the Rust GraphEntryError enum.
+type GraphEntryErrorKind string
+
+const (
+	GraphEntryInvalidContent GraphEntryErrorKind = "InvalidContent"
+	GraphEntryParsingFailed  GraphEntryErrorKind = "ParsingFailed"
+)
+
+var graphEntryErrorKinds = []GraphEntryErrorKind{GraphEntryInvalidContent, GraphEntryParsingFailed}
+
+// GraphEntryError represents a graph entry operation error. It matches ErrGraphEntry.
+type GraphEntryError struct {
+	Kind   GraphEntryErrorKind
+	Reason string
+}
+
+func (e *GraphEntryError) Error() string {
+	return formatRustError("graph entry", string(e.Kind), e.Reason)
+}
+
+func (e *GraphEntryError) Unwrap() error {
+	return ErrGraphEntry
+}
+
+func liftGraphEntryError(variant int32, reason string) error {
+	if kind, ok := errorKindAt(graphEntryErrorKinds, variant); ok {
+		return &GraphEntryError{Kind: kind, Reason: reason}
+	}
+	return nil
+}
+
+// VaultErrorKind identifies a variant of the Rust VaultError enum.
+type VaultErrorKind string
+
+const (
+	VaultInvalidKey      VaultErrorKind = "InvalidKey"
+	VaultParsingFailed   VaultErrorKind = "ParsingFailed"
+	VaultArchiveNotFound VaultErrorKind = "ArchiveNotFound"
+)
+
+var vaultErrorKinds = []VaultErrorKind{VaultInvalidKey, VaultParsingFailed, VaultArchiveNotFound}
+
+// VaultError represents a vault operation error. It matches ErrVault.
+// For VaultArchiveNotFound, Reason holds the missing archive.
+type VaultError struct {
+	Kind   VaultErrorKind
+	Reason string
+}
+
+func (e *VaultError) Error() string {
+	return formatRustError("vault", string(e.Kind), e.Reason)
+}
+
+func (e *VaultError) Unwrap() error {
+	return ErrVault
+}
+
+func liftVaultError(variant int32, reason string) error {
+	if kind, ok := errorKindAt(vaultErrorKinds, variant); ok {
+		return &VaultError{Kind: kind, Reason: reason}
+	}
+	return nil
+}
+
+// PeerError describes a bootstrap peer address that failed validation.
+type PeerError struct {
+	Peer   string
+	Reason string
+}
+
+func (e *PeerError) Error() string {
+	return fmt.Sprintf("invalid peer address %q: %s", e.Peer, e.Reason)
+}
+
+func (e *PeerError) Unwrap() error {
+	return ErrInvalidPeer
+}
+
+// VerificationError describes a pointer or scratchpad whose signature does not
+// match its owner.
+type VerificationError struct {
+	Kind   string
+	Reason string
+}
+
+func (e *VerificationError) Error() string {
+	return fmt.Sprintf("%s signature verification failed: %s", e.Kind, e.Reason)
+}
+
+func (e *VerificationError) Unwrap() error {
+	return ErrInvalidSignature
 }
@@ -0,0 +1,237 @@
+package antffi
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+// buildRustError hand-builds a serialized Rust error enum: the variant index
+// followed by its string field.
+func buildRustError(variant int32, reason string) []byte {
+	buf := make([]byte, 4+4+len(reason))
+	binary.BigEndian.PutUint32(buf[0:4], uint32(variant))
+	binary.BigEndian.PutUint32(buf[4:8], uint32(len(reason)))
+	copy(buf[8:], reason)
+	return buf
+}
+
+func TestLiftError(t *testing.T) {
+	cases := []struct {
+		name     string
+		lift     errorLifter
+		variant  int32
+		sentinel error
+		check    func(t *testing.T, err error)
+	}{
+		{"ClientError", liftClientError, 3, ErrClient, func(t *testing.T, err error) {
+			var e *ClientError
+			if !errors.As(err, &e) || e.Kind != ClientInvalidAddress {
+				t.Fatalf("Expected ClientInvalidAddress, got %v", err)
+			}
+		}},
+		{"ClientInvalidInput", liftClientError, 4, ErrClient, func(t *testing.T, err error) {
+			var e *ClientError
+			if !errors.As(err, &e) || e.Kind != ClientInvalidInput {
+				t.Fatalf("Expected ClientInvalidInput, got %v", err)
+			}
+		}},
+		{"WalletError", liftWalletError, 2, ErrWallet, func(t *testing.T, err error) {
+			var e *WalletError
+			if !errors.As(err, &e) || e.Kind != WalletBalanceCheckFailed {
+				t.Fatalf("Expected WalletBalanceCheckFailed, got %v", err)
+			}
+		}},
+		{"NetworkError", liftNetworkError, 1, ErrNetwork, func(t *testing.T, err error) {
+			var e *NetworkError
+			if !errors.As(err, &e) || e.Kind != NetworkCreationFailed {
+				t.Fatalf("Expected NetworkCreationFailed, got %v", err)
+			}
+		}},
+		{"KeyError", liftKeyError, 2, ErrKey, func(t *testing.T, err error) {
+			var e *KeyError
+			if !errors.As(err, &e) || e.Kind != KeyParsingFailed {
+				t.Fatalf("Expected KeyParsingFailed, got %v", err)
+			}
+		}},
+		{"EncryptionError", liftEncryptionError, 1, ErrEncryption, func(t *testing.T, err error) {
+			var e *EncryptionError
+			if !errors.As(err, &e) || e.Kind != EncryptionFailed {
+				t.Fatalf("Expected EncryptionFailed, got %v", err)
+			}
+		}},
+		{"DecryptionError", liftDecryptionError, 1, ErrDecryption, func(t *testing.T, err error) {
+			var e *DecryptionError
+			if !errors.As(err, &e) || e.Kind != EncryptionFailed {
+				t.Fatalf("Expected EncryptionFailed, got %v", err)
+			}
+		}},
+		{"DataError", liftDataError, 1, ErrData, func(t *testing.T, err error) {
+			var e *DataError
+			if !errors.As(err, &e) || e.Kind != DataInvalidData {
+				t.Fatalf("Expected DataInvalidData, got %v", err)
+			}
+		}},
+		{"ArchiveError", liftArchiveError, 3, ErrArchive, func(t *testing.T, err error) {
+			var e *ArchiveError
+			if !errors.As(err, &e) || e.Kind != ArchiveFileNotFound {
+				t.Fatalf("Expected ArchiveFileNotFound, got %v", err)
+			}
+		}},
+		{"PointerError", liftPointerError, 1, ErrPointer, func(t *testing.T, err error) {
+			var e *PointerError
+			if !errors.As(err, &e) || e.Kind != PointerInvalidPointer {
+				t.Fatalf("Expected PointerInvalidPointer, got %v", err)
+			}
+		}},
+		{"ScratchpadError", liftScratchpadError, 2, ErrScratchpad, func(t *testing.T, err error) {
+			var e *ScratchpadError
+			if !errors.As(err, &e) || e.Kind != ScratchpadParsingFailed {
+				t.Fatalf("Expected ScratchpadParsingFailed, got %v", err)
+			}
+		}},
+		{"RegisterError", liftRegisterError, 1, ErrRegister, func(t *testing.T, err error) {
+			var e *RegisterError
+			if !errors.As(err, &e) || e.Kind != RegisterInvalidRegister {
+				t.Fatalf("Expected RegisterInvalidRegister, got %v", err)
+			}
+		}},
+		{"GraphEntryError", liftGraphEntryError, 2, ErrGraphEntry, func(t *testing.T, err error) {
+			var e *GraphEntryError
+			if !errors.As(err, &e) || e.Kind != GraphEntryParsingFailed {
+				t.Fatalf("Expected GraphEntryParsingFailed, got %v", err)
+			}
+		}},
+		{"VaultError", liftVaultError, 3, ErrVault, func(t *testing.T, err error) {
+			var e *VaultError
+			if !errors.As(err, &e) || e.Kind != VaultArchiveNotFound {
+				t.Fatalf("Expected VaultArchiveNotFound, got %v", err)
+			}
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := liftError(buildRustError(tc.variant, "boom"), "op", tc.lift)
+			if !errors.Is(err, tc.sentinel) {
+				t.Fatalf("Expected %v, got %v", tc.sentinel, err)
+			}
+			if errors.Is(err, ErrMalformedResult) {
+				t.Fatalf("Unexpected ErrMalformedResult: %v", err)
+			}
+			tc.check(t, err)
+		})
+	}
+}
+
+func TestLiftErrorSentinelKinds(t *testing.T) {
+	network := liftError(buildRustError(1, "no peers"), "op", liftClientError)
+	if !errors.Is(network, ErrNetwork) || !errors.Is(network, ErrClient) {
+		t.Fatalf("Expected ErrNetwork and ErrClient, got %v", network)
+	}
+
+	init := liftError(buildRustError(2, "bad config"), "op", liftClientError)
+	if errors.Is(init, ErrNetwork) {
+		t.Fatalf("InitializationFailed should not match ErrNetwork: %v", init)
+	}
+
+	decrypt := liftError(buildRustError(3, "wrong key"), "op", liftScratchpadError)
+	if !errors.Is(decrypt, ErrDecryption) || !errors.Is(decrypt, ErrScratchpad) {
+		t.Fatalf("Expected ErrDecryption and ErrScratchpad, got %v", decrypt)
+	}
+}
+
+func TestLiftErrorReason(t *testing.T) {
+	err := liftError(buildRustError(1, "connection refused"), "op", liftClientError)
+
+	var clientErr *ClientError
+	if !errors.As(err, &clientErr) {
+		t.Fatalf("Expected *ClientError, got %T", err)
+	}
+	if clientErr.Reason != "connection refused" {
+		t.Fatalf("Reason mismatch: %q", clientErr.Reason)
+	}
+	if err.Error() != "client error: NetworkError: connection refused" {
+		t.Fatalf("Unexpected message: %q", err.Error())
+	}
+}
+
+func TestLiftErrorMalformed(t *testing.T) {
+	valid := buildRustError(1, "boom")
+
+	cases := map[string][]byte{
+		"empty":            nil,
+		"truncated reason": valid[:len(valid)-1],
+		"trailing bytes":   append(append([]byte(nil), valid...), 0),
+		"variant zero":     buildRustError(0, "boom"),
+		"unknown variant":  buildRustError(5, "boom"),
+	}
+
+	for name, data := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := liftError(data, "op", liftClientError)
+			if !errors.Is(err, ErrMalformedResult) {
+				t.Fatalf("Expected ErrMalformedResult, got %v", err)
+			}
+		})
+	}
+}
+
+func TestClassifyStatus(t *testing.T) {
+	if err := classifyStatus(0, nil, "op", liftClientError); err != nil {
+		t.Fatalf("Expected nil for success, got %v", err)
+	}
+
+	declared := classifyStatus(1, buildRustError(1, "no peers"), "op", liftClientError)
+	var clientErr *ClientError
+	if !errors.As(declared, &clientErr) {
+		t.Fatalf("Expected *ClientError for code 1, got %T", declared)
+	}
+	if errors.Is(declared, ErrRustPanic) {
+		t.Fatalf("Declared error should not match ErrRustPanic: %v", declared)
+	}
+
+	untyped := classifyStatus(1, []byte("failure"), "op", nil)
+	var ffiErr *AntFFIError
+	if !errors.As(untyped, &ffiErr) || ffiErr.Code != 1 {
+		t.Fatalf("Expected *AntFFIError with code 1, got %v", untyped)
+	}
+
+	unknown := classifyStatus(3, nil, "op", liftClientError)
+	if !errors.As(unknown, &ffiErr) || ffiErr.Code != 3 || ffiErr.Message != "op failed" {
+		t.Fatalf("Expected *AntFFIError with code 3, got %v", unknown)
+	}
+}
+
+func TestClassifyStatusPanic(t *testing.T) {
+	lifters := map[string]errorLifter{
+		"sync with lifter":  liftKeyError,
+		"sync without lift": nil,
+		"async operation":   liftClientError,
+	}
+
+	for name, lift := range lifters {
+		t.Run(name, func(t *testing.T) {
+			err := classifyStatus(2, []byte("index out of bounds"), "op", lift)
+			if !errors.Is(err, ErrRustPanic) {
+				t.Fatalf("Expected ErrRustPanic, got %v", err)
+			}
+			if errors.Is(err, ErrClient) || errors.Is(err, ErrNetwork) || errors.Is(err, ErrKey) {
+				t.Fatalf("Panic should not match declared error sentinels: %v", err)
+			}
+
+			var panicErr *PanicError
+			if !errors.As(err, &panicErr) {
+				t.Fatalf("Expected *PanicError, got %T", err)
+			}
+			if panicErr.Message != "index out of bounds" || panicErr.Operation != "op" {
+				t.Fatalf("Unexpected panic error: %+v", panicErr)
+			}
+		})
+	}
+
+	empty := classifyStatus(2, nil, "op", nil)
+	if empty.Error() != "rust panic in op" {
+		t.Fatalf("Unexpected message: %q", empty.Error())
+	}
+}
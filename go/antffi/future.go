@@ -0,0 +1,216 @@
+package antffi
+
+import (
+	"context"
+	"errors"
+)
+
+// Future is the pending result of an operation started with Start or one of
+// the Client.Start* methods.
+//
+// Cancelling a Future cancels the context its operation runs with, which makes
+// the in-flight Rust future be cancelled through rust_future_cancel.
+type Future[T any] struct {
+	done   chan struct{}
+	cancel context.CancelFunc
+	value  T
+	err    error
+}
+
+// Start runs op in a new goroutine and returns a Future for its result.
+// op receives a context derived from ctx that is cancelled by Future.Cancel.
+func Start[T any](ctx context.Context, op func(context.Context) (T, error)) *Future[T] {
+	opCtx, cancel := context.WithCancel(ctx)
+	f := &Future[T]{done: make(chan struct{}), cancel: cancel}
+
+	go func() {
+		defer cancel()
+		f.value, f.err = op(opCtx)
+		close(f.done)
+	}()
+
+	return f
+}
+
+// Await waits for the operation to finish and returns its result.
+// If ctx is done first, Await returns ctx.Err() and the operation keeps running;
+// call Cancel to stop it.
+func (f *Future[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.value, f.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// Done returns a channel that is closed when the operation has finished.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Cancel stops the operation if it is still running. It does not wait for it
+// to finish; the Future then completes with the cancellation error.
+func (f *Future[T]) Cancel() {
+	f.cancel()
+}
+
+// Result returns the operation's result without blocking.
+// It returns ErrNotReady if the operation has not finished.
+func (f *Future[T]) Result() (T, error) {
+	select {
+	case <-f.done:
+		return f.value, f.err
+	default:
+		var zero T
+		return zero, ErrNotReady
+	}
+}
+
+// AwaitAll waits for every future and returns their results in order.
+// On the first failure, or if ctx is done, the remaining futures are cancelled
+// and that error is returned. Results that have a Free method are then freed,
+// including those of futures that finish after AwaitAll returns.
+func AwaitAll[T any](ctx context.Context, futures ...*Future[T]) ([]T, error) {
+	if err := checkFutures(futures); err != nil {
+		return nil, err
+	}
+
+	ready, stop := completions(futures)
+	defer stop()
+
+	results := make([]T, len(futures))
+	for range futures {
+		select {
+		case index := <-ready:
+			value, err := futures[index].Result()
+			if err != nil {
+				cancelAll(futures)
+				freeResults(futures)
+				return nil, err
+			}
+			results[index] = value
+		case <-ctx.Done():
+			cancelAll(futures)
+			freeResults(futures)
+			return nil, ctx.Err()
+		}
+	}
+
+	return results, nil
+}
+
+// AwaitAny waits for the first future to succeed and returns its index and value,
+// cancelling the others. If every future fails, the joined errors are returned.
+func AwaitAny[T any](ctx context.Context, futures ...*Future[T]) (int, T, error) {
+	var zero T
+	if len(futures) == 0 {
+		return -1, zero, ErrInvalidArgument
+	}
+	if err := checkFutures(futures); err != nil {
+		return -1, zero, err
+	}
+
+	ready, stop := completions(futures)
+	defer stop()
+
+	errs := make([]error, 0, len(futures))
+	for range futures {
+		select {
+		case index := <-ready:
+			value, err := futures[index].Result()
+			if err == nil {
+				cancelAll(futures)
+				return index, value, nil
+			}
+			errs = append(errs, err)
+		case <-ctx.Done():
+			cancelAll(futures)
+			return -1, zero, ctx.Err()
+		}
+	}
+
+	return -1, zero, errors.Join(errs...)
+}
+
+// checkFutures rejects a nil future.
+func checkFutures[T any](futures []*Future[T]) error {
+	for _, f := range futures {
+		if f == nil {
+			return ErrNilPointer
+		}
+	}
+	return nil
+}
+
+// freeResults frees the successful results of futures once they finish, for
+// result types with a Free method. It does not wait for them.
+func freeResults[T any](futures []*Future[T]) {
+	var zero T
+	if _, ok := any(zero).(interface{ Free() }); !ok {
+		return
+	}
+	for _, f := range futures {
+		go func(f *Future[T]) {
+			<-f.done
+			if f.err == nil {
+				any(f.value).(interface{ Free() }).Free()
+			}
+		}(f)
+	}
+}
+
+// completions reports the index of each future as it finishes.
+// Call stop once no more completions are needed.
+func completions[T any](futures []*Future[T]) (ready <-chan int, stop func()) {
+	ch := make(chan int, len(futures))
+	done := make(chan struct{})
+
+	for i, f := range futures {
+		go func(i int, f *Future[T]) {
+			select {
+			case <-f.done:
+				ch <- i
+			case <-done:
+			}
+		}(i, f)
+	}
+
+	return ch, func() { close(done) }
+}
+
+// cancelAll cancels every future; finished futures are unaffected.
+func cancelAll[T any](futures []*Future[T]) {
+	for _, f := range futures {
+		f.Cancel()
+	}
+}
+
+// StartDataGetPublic starts DataGetPublic and returns a Future for its result.
+func (c *Client) StartDataGetPublic(ctx context.Context, addressHex string) *Future[[]byte] {
+	return Start(ctx, func(ctx context.Context) ([]byte, error) {
+		return c.DataGetPublic(ctx, addressHex)
+	})
+}
+
+// StartDataGet starts DataGet and returns a Future for its result.
+func (c *Client) StartDataGet(ctx context.Context, dataMapChunk *DataMapChunk) *Future[[]byte] {
+	return Start(ctx, func(ctx context.Context) ([]byte, error) {
+		return c.DataGet(ctx, dataMapChunk)
+	})
+}
+
+// StartChunkGet starts ChunkGet and returns a Future for its result.
+func (c *Client) StartChunkGet(ctx context.Context, address *ChunkAddress) *Future[*Chunk] {
+	return Start(ctx, func(ctx context.Context) (*Chunk, error) {
+		return c.ChunkGet(ctx, address)
+	})
+}
+
+// StartPointerGet starts PointerGet and returns a Future for its result.
+func (c *Client) StartPointerGet(ctx context.Context, address *PointerAddress) *Future[*NetworkPointer] {
+	return Start(ctx, func(ctx context.Context) (*NetworkPointer, error) {
+		return c.PointerGet(ctx, address)
+	})
+}
@@ -0,0 +1,215 @@
+package antffi
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// startFake starts a Future driven by a fakeFuture, as a Client.Start* call would be.
+func startFake(ctx context.Context, f *fakeFuture, value int) *Future[int] {
+	return Start(ctx, func(ctx context.Context) (int, error) {
+		if _, err := driveFuture(ctx, 1, f); err != nil {
+			return 0, err
+		}
+		return value, nil
+	})
+}
+
+func TestFutureAwait(t *testing.T) {
+	f := startFake(context.Background(), newFakeFuture(3, false), 42)
+
+	value, err := f.Await(context.Background())
+	if err != nil || value != 42 {
+		t.Fatalf("Await = %d, %v", value, err)
+	}
+
+	select {
+	case <-f.Done():
+	default:
+		t.Fatal("Done not closed after Await")
+	}
+
+	if value, err := f.Result(); err != nil || value != 42 {
+		t.Fatalf("Result = %d, %v", value, err)
+	}
+}
+
+func TestFutureResultNotReady(t *testing.T) {
+	fake := newFakeFuture(0, true)
+	f := startFake(context.Background(), fake, 1)
+
+	if _, err := f.Result(); !errors.Is(err, ErrNotReady) {
+		t.Fatalf("Expected ErrNotReady, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if _, err := f.Await(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected Await to time out, got %v", err)
+	}
+
+	f.Cancel()
+	if _, err := f.Await(context.Background()); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled after Cancel, got %v", err)
+	}
+	if fake.cancels != 1 || fake.frees != 1 {
+		t.Fatalf("Expected the Rust future cancelled and freed once, got cancels=%d frees=%d", fake.cancels, fake.frees)
+	}
+}
+
+func TestAwaitAll(t *testing.T) {
+	futures := []*Future[int]{
+		startFake(context.Background(), newFakeFuture(5, false), 1),
+		startFake(context.Background(), newFakeFuture(0, false), 2),
+		startFake(context.Background(), newFakeFuture(2, false), 3),
+	}
+
+	results, err := AwaitAll(context.Background(), futures...)
+	if err != nil {
+		t.Fatalf("AwaitAll failed: %v", err)
+	}
+	for i, want := range []int{1, 2, 3} {
+		if results[i] != want {
+			t.Fatalf("results[%d] = %d, want %d", i, results[i], want)
+		}
+	}
+}
+
+func TestAwaitAllCancelsOnFailure(t *testing.T) {
+	blocked := newFakeFuture(0, true)
+	failure := errors.New("boom")
+
+	futures := []*Future[int]{
+		startFake(context.Background(), blocked, 1),
+		Start(context.Background(), func(ctx context.Context) (int, error) { return 0, failure }),
+	}
+
+	if _, err := AwaitAll(context.Background(), futures...); !errors.Is(err, failure) {
+		t.Fatalf("Expected failure, got %v", err)
+	}
+
+	<-futures[0].Done()
+	if blocked.cancels != 1 || blocked.frees != 1 {
+		t.Fatalf("Expected remaining Rust future cancelled, got cancels=%d frees=%d", blocked.cancels, blocked.frees)
+	}
+}
+
+func TestAwaitAny(t *testing.T) {
+	slow := []*fakeFuture{newFakeFuture(0, true), newFakeFuture(0, true)}
+	futures := []*Future[int]{
+		startFake(context.Background(), slow[0], 1),
+		startFake(context.Background(), newFakeFuture(1, false), 2),
+		startFake(context.Background(), slow[1], 3),
+	}
+
+	index, value, err := AwaitAny(context.Background(), futures...)
+	if err != nil || index != 1 || value != 2 {
+		t.Fatalf("AwaitAny = %d, %d, %v", index, value, err)
+	}
+
+	for i, fake := range slow {
+		<-futures[i*2].Done()
+		if fake.cancels != 1 || fake.frees != 1 {
+			t.Fatalf("Expected slow future %d cancelled, got cancels=%d frees=%d", i, fake.cancels, fake.frees)
+		}
+	}
+}
+
+func TestAwaitAnyAllFail(t *testing.T) {
+	first := errors.New("first")
+	second := errors.New("second")
+
+	_, _, err := AwaitAny(context.Background(),
+		Start(context.Background(), func(ctx context.Context) (int, error) { return 0, first }),
+		Start(context.Background(), func(ctx context.Context) (int, error) { return 0, second }),
+	)
+	if !errors.Is(err, first) || !errors.Is(err, second) {
+		t.Fatalf("Expected joined errors, got %v", err)
+	}
+
+	if _, _, err := AwaitAny[int](context.Background()); !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("Expected ErrInvalidArgument for no futures, got %v", err)
+	}
+}
+
+func TestAwaitAllContextDone(t *testing.T) {
+	blocked := newFakeFuture(0, true)
+	f := startFake(context.Background(), blocked, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+
+	if _, err := AwaitAll(ctx, f); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected DeadlineExceeded, got %v", err)
+	}
+	<-f.Done()
+	if blocked.cancels != 1 {
+		t.Fatalf("Expected the Rust future cancelled, got %d cancels", blocked.cancels)
+	}
+}
+
+// freeCounter is a result with a Free method, counting how often it is freed.
+type freeCounter struct {
+	mu    sync.Mutex
+	frees int
+}
+
+func (c *freeCounter) Free() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.frees++
+}
+
+func (c *freeCounter) count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.frees
+}
+
+func TestAwaitNilFuture(t *testing.T) {
+	f := Start(context.Background(), func(ctx context.Context) (int, error) { return 1, nil })
+
+	if _, err := AwaitAll(context.Background(), f, nil); !errors.Is(err, ErrNilPointer) {
+		t.Fatalf("AwaitAll: expected ErrNilPointer, got %v", err)
+	}
+	if _, _, err := AwaitAny(context.Background(), nil, f); !errors.Is(err, ErrNilPointer) {
+		t.Fatalf("AwaitAny: expected ErrNilPointer, got %v", err)
+	}
+}
+
+func TestAwaitAllFreesAbandonedResults(t *testing.T) {
+	done, late := &freeCounter{}, &freeCounter{}
+	release := make(chan struct{})
+	failure := errors.New("failed")
+
+	first := Start(context.Background(), func(ctx context.Context) (*freeCounter, error) { return done, nil })
+	futures := []*Future[*freeCounter]{
+		first,
+		Start(context.Background(), func(ctx context.Context) (*freeCounter, error) {
+			<-first.Done()
+			return nil, failure
+		}),
+		// Ignores cancellation, so it succeeds after AwaitAll has returned.
+		Start(context.Background(), func(ctx context.Context) (*freeCounter, error) {
+			<-release
+			return late, nil
+		}),
+	}
+
+	if _, err := AwaitAll(context.Background(), futures...); !errors.Is(err, failure) {
+		t.Fatalf("Expected the failure, got %v", err)
+	}
+	close(release)
+	<-futures[2].Done()
+
+	deadline := time.Now().Add(time.Second)
+	for done.count() != 1 || late.count() != 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected each abandoned result freed once, got %d and %d", done.count(), late.count())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
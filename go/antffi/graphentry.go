@@ -27,12 +27,14 @@ extern void* uniffi_ant_ffi_fn_method_graphentry_address(void* ptr, RustCallStat
 extern RustBuffer uniffi_ant_ffi_fn_method_graphentry_content(void* ptr, RustCallStatus* status);
 extern RustBuffer uniffi_ant_ffi_fn_method_graphentry_parents(void* ptr, RustCallStatus* status);
 extern RustBuffer uniffi_ant_ffi_fn_method_graphentry_descendants(void* ptr, RustCallStatus* status);
+extern RustBuffer uniffi_ant_ffi_fn_method_graphentry_snapshot(void* ptr, RustCallStatus* status);
 extern void uniffi_ant_ffi_fn_free_graphentry(void* ptr, RustCallStatus* status);
 extern void* uniffi_ant_ffi_fn_clone_graphentry(void* ptr, RustCallStatus* status);
 */
 import "C"
 
 import (
+	"encoding/binary"
 	"runtime"
 	"sync"
 	"unsafe"
@@ -57,9 +59,10 @@ func NewGraphEntryAddress(pk *PublicKey) (*GraphEntryAddress, error) {
 	}
 
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_constructor_graphentryaddress_new(cloned, &status)
 
-	if err := checkStatus(&status, "GraphEntryAddress.New"); err != nil {
+	if err := checkStatus(&status, "GraphEntryAddress.New", liftGraphEntryError); err != nil {
 		return nil, err
 	}
 
@@ -73,7 +76,7 @@ func GraphEntryAddressFromHex(hex string) (*GraphEntryAddress, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_graphentryaddress_from_hex(hexBuffer, &status)
 
-	if err := checkStatus(&status, "GraphEntryAddress.FromHex"); err != nil {
+	if err := checkStatus(&status, "GraphEntryAddress.FromHex", liftGraphEntryError); err != nil {
 		return nil, err
 	}
 
@@ -83,6 +86,7 @@ func GraphEntryAddressFromHex(hex string) (*GraphEntryAddress, error) {
 func newGraphEntryAddress(handle unsafe.Pointer) *GraphEntryAddress {
 	gea := &GraphEntryAddress{handle: handle}
 	runtime.SetFinalizer(gea, (*GraphEntryAddress).Free)
+	trackHandle(unsafe.Pointer(gea), "GraphEntryAddress", gea.handle)
 	return gea
 }
 
@@ -96,9 +100,16 @@ func (gea *GraphEntryAddress) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_graphentryaddress(gea.handle, &status)
+	untrackHandle(unsafe.Pointer(gea))
 	gea.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (gea *GraphEntryAddress) Close() error {
+	gea.Free()
+	return nil
+}
+
 func (gea *GraphEntryAddress) ToHex() (string, error) {
 	gea.mu.Lock()
 	defer gea.mu.Unlock()
@@ -109,9 +120,10 @@ func (gea *GraphEntryAddress) ToHex() (string, error) {
 
 	cloned := gea.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_graphentryaddress_to_hex(cloned, &status)
 
-	if err := checkStatus(&status, "GraphEntryAddress.ToHex"); err != nil {
+	if err := checkStatus(&status, "GraphEntryAddress.ToHex", liftGraphEntryError); err != nil {
 		return "", err
 	}
 
@@ -120,7 +132,7 @@ func (gea *GraphEntryAddress) ToHex() (string, error) {
 
 func (gea *GraphEntryAddress) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_graphentryaddress(gea.handle, &status)
+	return trackClone("GraphEntryAddress", C.uniffi_ant_ffi_fn_clone_graphentryaddress(gea.handle, &status))
 }
 
 func (gea *GraphEntryAddress) CloneHandle() unsafe.Pointer {
@@ -132,6 +144,66 @@ func (gea *GraphEntryAddress) CloneHandle() unsafe.Pointer {
 	return gea.cloneHandle()
 }
 
+// GraphDescendantSnapshot is a GraphDescendant with its key as hex instead of a handle.
+type GraphDescendantSnapshot struct {
+	PublicKey string
+	Content   [GraphContentSize]byte
+}
+
+// GraphEntrySnapshot holds every field of a GraphEntry as plain Go values.
+type GraphEntrySnapshot struct {
+	// Address is the hex-encoded address where the entry is stored.
+	Address string
+	// Owner is the hex-encoded public key of the owner.
+	Owner string
+	// Content is the entry's content.
+	Content [GraphContentSize]byte
+	// Parents are the hex-encoded public keys of the entry's parents.
+	Parents []string
+	// Descendants are the entry's descendants.
+	Descendants []GraphDescendantSnapshot
+}
+
+// decodeGraphEntrySnapshot decodes a UniFFI-serialized GraphEntrySnapshot record.
+func decodeGraphEntrySnapshot(data []byte) (GraphEntrySnapshot, error) {
+	reader := NewUniFFIReader(data)
+	snapshot := GraphEntrySnapshot{
+		Address: reader.ReadString(),
+		Owner:   reader.ReadString(),
+	}
+	if n := copy(snapshot.Content[:], reader.ReadBytes()); n != GraphContentSize {
+		return GraphEntrySnapshot{}, malformedRecord("GraphEntrySnapshot", len(data))
+	}
+
+	count, ok := reader.readCount()
+	if !ok {
+		return GraphEntrySnapshot{}, malformedRecord("GraphEntrySnapshot", len(data))
+	}
+	snapshot.Parents = make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		snapshot.Parents = append(snapshot.Parents, reader.ReadString())
+	}
+
+	count, ok = reader.readCount()
+	if !ok {
+		return GraphEntrySnapshot{}, malformedRecord("GraphEntrySnapshot", len(data))
+	}
+	snapshot.Descendants = make([]GraphDescendantSnapshot, 0, count)
+	for i := 0; i < count; i++ {
+		descendant := GraphDescendantSnapshot{PublicKey: reader.ReadString()}
+		if n := copy(descendant.Content[:], reader.ReadBytes()); n != GraphContentSize {
+			return GraphEntrySnapshot{}, malformedRecord("GraphEntrySnapshot", len(data))
+		}
+		snapshot.Descendants = append(snapshot.Descendants, descendant)
+	}
+
+	if reader.remaining() != 0 {
+		return GraphEntrySnapshot{}, malformedRecord("GraphEntrySnapshot", len(data))
+	}
+
+	return snapshot, nil
+}
+
 // GraphEntry represents a graph entry.
 type GraphEntry struct {
 	handle unsafe.Pointer
@@ -139,35 +211,108 @@ type GraphEntry struct {
 	mu     sync.Mutex
 }
 
-// NewGraphEntry creates a new GraphEntry.
-func NewGraphEntry(owner *SecretKey, parents, content, descendants []byte) (*GraphEntry, error) {
+// GraphContentSize is the size of graph entry content and descendant content.
+const GraphContentSize = 32
+
+// GraphDescendant is a descendant of a graph entry: a public key and its content.
+type GraphDescendant struct {
+	PublicKey *PublicKey
+	Content   [GraphContentSize]byte
+}
+
+// NewGraphEntry creates a new GraphEntry signed by owner.
+func NewGraphEntry(owner *SecretKey, parents []*PublicKey, content [GraphContentSize]byte, descendants []GraphDescendant) (*GraphEntry, error) {
 	if owner == nil {
 		return nil, ErrInvalidArgument
 	}
+	for _, parent := range parents {
+		if parent == nil {
+			return nil, ErrInvalidArgument
+		}
+	}
+	for _, descendant := range descendants {
+		if descendant.PublicKey == nil {
+			return nil, ErrInvalidArgument
+		}
+	}
+
+	// Clone every key handle up front so a disposed key fails before anything is handed to Rust.
+	var handles []unsafe.Pointer
+	releaseHandles := func() {
+		for _, h := range handles {
+			consumeClones(h)
+			newPublicKey(h).Free()
+		}
+	}
+	for _, parent := range parents {
+		h := parent.CloneHandle()
+		if h == nil {
+			releaseHandles()
+			return nil, ErrDisposed
+		}
+		handles = append(handles, h)
+	}
+	for _, descendant := range descendants {
+		h := descendant.PublicKey.CloneHandle()
+		if h == nil {
+			releaseHandles()
+			return nil, ErrDisposed
+		}
+		handles = append(handles, h)
+	}
 
 	clonedOwner := owner.CloneHandle()
 	if clonedOwner == nil {
+		releaseHandles()
 		return nil, ErrDisposed
 	}
 
-	parentsBuffer := toRustBuffer(parents)
-	contentBuffer := toRustBuffer(content)
-	descendantsBuffer := toRustBuffer(descendants)
+	parentsBuffer := rawToRustBuffer(encodeGraphParents(handles[:len(parents)]))
+	contentBuffer := toRustBuffer(content[:])
+	descendantsBuffer := rawToRustBuffer(encodeGraphDescendants(handles[len(parents):], descendants))
 
 	var status C.RustCallStatus
+	consumeClones(append(handles, clonedOwner)...)
 	handle := C.uniffi_ant_ffi_fn_constructor_graphentry_new(
 		clonedOwner, parentsBuffer, contentBuffer, descendantsBuffer, &status)
 
-	if err := checkStatus(&status, "GraphEntry.New"); err != nil {
+	if err := checkStatus(&status, "GraphEntry.New", liftGraphEntryError); err != nil {
 		return nil, err
 	}
 
 	return newGraphEntry(handle), nil
 }
 
+// encodeGraphParents serializes cloned public key handles as Vec<Arc<PublicKey>>.
+func encodeGraphParents(handles []unsafe.Pointer) []byte {
+	buf := make([]byte, 4+8*len(handles))
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(handles)))
+	for i, h := range handles {
+		binary.BigEndian.PutUint64(buf[4+8*i:], uint64(uintptr(h)))
+	}
+	return buf
+}
+
+// encodeGraphDescendants serializes descendants as Vec<GraphDescendant>,
+// using the cloned public key handle for each descendant.
+func encodeGraphDescendants(handles []unsafe.Pointer, descendants []GraphDescendant) []byte {
+	const itemSize = 8 + 4 + GraphContentSize
+	buf := make([]byte, 4+itemSize*len(descendants))
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(descendants)))
+	offset := 4
+	for i, descendant := range descendants {
+		binary.BigEndian.PutUint64(buf[offset:], uint64(uintptr(handles[i])))
+		binary.BigEndian.PutUint32(buf[offset+8:], GraphContentSize)
+		copy(buf[offset+12:], descendant.Content[:])
+		offset += itemSize
+	}
+	return buf
+}
+
 func newGraphEntry(handle unsafe.Pointer) *GraphEntry {
 	ge := &GraphEntry{handle: handle}
 	runtime.SetFinalizer(ge, (*GraphEntry).Free)
+	trackHandle(unsafe.Pointer(ge), "GraphEntry", ge.handle)
 	return ge
 }
 
@@ -181,9 +326,16 @@ func (ge *GraphEntry) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_graphentry(ge.handle, &status)
+	untrackHandle(unsafe.Pointer(ge))
 	ge.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (ge *GraphEntry) Close() error {
+	ge.Free()
+	return nil
+}
+
 func (ge *GraphEntry) Address() (*GraphEntryAddress, error) {
 	ge.mu.Lock()
 	defer ge.mu.Unlock()
@@ -194,9 +346,10 @@ func (ge *GraphEntry) Address() (*GraphEntryAddress, error) {
 
 	cloned := ge.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_method_graphentry_address(cloned, &status)
 
-	if err := checkStatus(&status, "GraphEntry.Address"); err != nil {
+	if err := checkStatus(&status, "GraphEntry.Address", liftGraphEntryError); err != nil {
 		return nil, err
 	}
 
@@ -213,16 +366,19 @@ func (ge *GraphEntry) Content() ([]byte, error) {
 
 	cloned := ge.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_graphentry_content(cloned, &status)
 
-	if err := checkStatus(&status, "GraphEntry.Content"); err != nil {
+	if err := checkStatus(&status, "GraphEntry.Content", liftGraphEntryError); err != nil {
 		return nil, err
 	}
 
 	return fromRustBuffer(result, true), nil
 }
 
-func (ge *GraphEntry) Parents() ([]byte, error) {
+// Parents returns the public keys of the entry's parents.
+// The returned keys are owned by the caller.
+func (ge *GraphEntry) Parents() ([]*PublicKey, error) {
 	ge.mu.Lock()
 	defer ge.mu.Unlock()
 
@@ -232,16 +388,30 @@ func (ge *GraphEntry) Parents() ([]byte, error) {
 
 	cloned := ge.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_graphentry_parents(cloned, &status)
 
-	if err := checkStatus(&status, "GraphEntry.Parents"); err != nil {
+	if err := checkStatus(&status, "GraphEntry.Parents", liftGraphEntryError); err != nil {
 		return nil, err
 	}
 
-	return fromRustBuffer(result, true), nil
+	// Deserialize Vec<Arc<PublicKey>>
+	reader := NewUniFFIReader(fromRustBufferRaw(result, true))
+	count := reader.ReadInt32()
+	if count < 0 {
+		return nil, ErrInvalidArgument
+	}
+	parents := make([]*PublicKey, 0, count)
+	for i := int32(0); i < count; i++ {
+		parents = append(parents, newPublicKey(reader.ReadPointer()))
+	}
+
+	return parents, nil
 }
 
-func (ge *GraphEntry) Descendants() ([]byte, error) {
+// Descendants returns the entry's descendants.
+// The returned keys are owned by the caller.
+func (ge *GraphEntry) Descendants() ([]GraphDescendant, error) {
 	ge.mu.Lock()
 	defer ge.mu.Unlock()
 
@@ -251,18 +421,54 @@ func (ge *GraphEntry) Descendants() ([]byte, error) {
 
 	cloned := ge.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_graphentry_descendants(cloned, &status)
 
-	if err := checkStatus(&status, "GraphEntry.Descendants"); err != nil {
+	if err := checkStatus(&status, "GraphEntry.Descendants", liftGraphEntryError); err != nil {
 		return nil, err
 	}
 
-	return fromRustBuffer(result, true), nil
+	// Deserialize Vec<GraphDescendant> (public_key: Arc<PublicKey>, content: Vec<u8>)
+	reader := NewUniFFIReader(fromRustBufferRaw(result, true))
+	count := reader.ReadInt32()
+	if count < 0 {
+		return nil, ErrInvalidArgument
+	}
+	descendants := make([]GraphDescendant, 0, count)
+	for i := int32(0); i < count; i++ {
+		descendant := GraphDescendant{PublicKey: newPublicKey(reader.ReadPointer())}
+		copy(descendant.Content[:], reader.ReadBytes())
+		descendants = append(descendants, descendant)
+	}
+
+	return descendants, nil
+}
+
+// Snapshot returns every field of the entry in a single FFI call. Unlike
+// Parents and Descendants it returns keys as hex, so there is nothing to free.
+func (ge *GraphEntry) Snapshot() (GraphEntrySnapshot, error) {
+	ge.mu.Lock()
+	defer ge.mu.Unlock()
+
+	if ge.freed {
+		return GraphEntrySnapshot{}, ErrDisposed
+	}
+
+	cloned := ge.cloneHandle()
+	var status C.RustCallStatus
+	consumeClones(cloned)
+	result := C.uniffi_ant_ffi_fn_method_graphentry_snapshot(cloned, &status)
+
+	if err := checkStatus(&status, "GraphEntry.Snapshot", liftGraphEntryError); err != nil {
+		return GraphEntrySnapshot{}, err
+	}
+
+	return decodeGraphEntrySnapshot(fromRustBufferRaw(result, true))
 }
 
 func (ge *GraphEntry) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_graphentry(ge.handle, &status)
+	return trackClone("GraphEntry", C.uniffi_ant_ffi_fn_clone_graphentry(ge.handle, &status))
 }
 
 func (ge *GraphEntry) CloneHandle() unsafe.Pointer {
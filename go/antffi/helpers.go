@@ -27,6 +27,7 @@ import "C"
 
 import (
 	"encoding/binary"
+	"fmt"
 	"unsafe"
 )
 
@@ -43,6 +44,7 @@ func toRustBuffer(data []byte) C.RustBuffer {
 			data: (*C.uint8_t)(unsafe.Pointer(&buf[0])),
 		}
 
+		traceCgo("toRustBuffer", int(fb.len))
 		var status C.RustCallStatus
 		return C.ffi_ant_ffi_rustbuffer_from_bytes(fb, &status)
 	}
@@ -57,6 +59,7 @@ func toRustBuffer(data []byte) C.RustBuffer {
 		data: (*C.uint8_t)(unsafe.Pointer(&buf[0])),
 	}
 
+	traceCgo("toRustBuffer", int(fb.len))
 	var status C.RustCallStatus
 	return C.ffi_ant_ffi_rustbuffer_from_bytes(fb, &status)
 }
@@ -74,6 +77,24 @@ func stringToRustBuffer(s string) C.RustBuffer {
 		data: (*C.uint8_t)(unsafe.Pointer(&data[0])),
 	}
 
+	traceCgo("stringToRustBuffer", int(fb.len))
+	var status C.RustCallStatus
+	return C.ffi_ant_ffi_rustbuffer_from_bytes(fb, &status)
+}
+
+// rawToRustBuffer copies already-serialized bytes into a RustBuffer as-is.
+// Used for compound UniFFI arguments (sequences, records) encoded on the Go side.
+func rawToRustBuffer(data []byte) C.RustBuffer {
+	if len(data) == 0 {
+		return C.RustBuffer{}
+	}
+
+	fb := C.ForeignBytes{
+		len:  C.int32_t(len(data)),
+		data: (*C.uint8_t)(unsafe.Pointer(&data[0])),
+	}
+
+	traceCgo("rawToRustBuffer", int(fb.len))
 	var status C.RustCallStatus
 	return C.ffi_ant_ffi_rustbuffer_from_bytes(fb, &status)
 }
@@ -82,6 +103,7 @@ func stringToRustBuffer(s string) C.RustBuffer {
 // the UniFFI format (skipping the 4-byte length prefix).
 // If free is true, the RustBuffer is freed after extraction.
 func fromRustBuffer(buf C.RustBuffer, free bool) []byte {
+	traceCgo("fromRustBuffer", int(buf.len))
 	if buf.len == 0 {
 		if free {
 			freeRustBuffer(buf)
@@ -110,6 +132,7 @@ func fromRustBuffer(buf C.RustBuffer, free bool) []byte {
 // fromRustBufferRaw extracts raw bytes from a RustBuffer without UniFFI deserialization.
 // If free is true, the RustBuffer is freed after extraction.
 func fromRustBufferRaw(buf C.RustBuffer, free bool) []byte {
+	traceCgo("fromRustBufferRaw", int(buf.len))
 	if buf.len == 0 {
 		if free {
 			freeRustBuffer(buf)
@@ -130,6 +153,7 @@ func fromRustBufferRaw(buf C.RustBuffer, free bool) []byte {
 // UniFFI returns strings as raw UTF-8 bytes without length prefix.
 // The RustBuffer is freed after extraction.
 func stringFromRustBuffer(buf C.RustBuffer) string {
+	traceCgo("stringFromRustBuffer", int(buf.len))
 	if buf.len == 0 {
 		freeRustBuffer(buf)
 		return ""
@@ -172,6 +196,34 @@ func optionStringToRustBuffer(s *string) C.RustBuffer {
 		data: (*C.uint8_t)(unsafe.Pointer(&buf[0])),
 	}
 
+	traceCgo("optionStringToRustBuffer", int(fb.len))
+	var status C.RustCallStatus
+	return C.ffi_ant_ffi_rustbuffer_from_bytes(fb, &status)
+}
+
+// stringSliceToRustBuffer converts a Go string slice to a RustBuffer with UniFFI Vec<String> serialization.
+// Format: 4-byte big-endian count, then for each string a 4-byte big-endian length + UTF-8 bytes.
+func stringSliceToRustBuffer(items []string) C.RustBuffer {
+	size := 4
+	for _, item := range items {
+		size += 4 + len(item)
+	}
+
+	buf := make([]byte, size)
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(items)))
+	offset := 4
+	for _, item := range items {
+		binary.BigEndian.PutUint32(buf[offset:offset+4], uint32(len(item)))
+		offset += 4
+		offset += copy(buf[offset:], item)
+	}
+
+	fb := C.ForeignBytes{
+		len:  C.int32_t(len(buf)),
+		data: (*C.uint8_t)(unsafe.Pointer(&buf[0])),
+	}
+
+	traceCgo("stringSliceToRustBuffer", int(fb.len))
 	var status C.RustCallStatus
 	return C.ffi_ant_ffi_rustbuffer_from_bytes(fb, &status)
 }
@@ -194,6 +246,7 @@ func lowerPaymentOption(walletPtr unsafe.Pointer) C.RustBuffer {
 		data: (*C.uint8_t)(unsafe.Pointer(&buf[0])),
 	}
 
+	traceCgo("lowerPaymentOption", int(fb.len))
 	var status C.RustCallStatus
 	return C.ffi_ant_ffi_rustbuffer_from_bytes(fb, &status)
 }
@@ -262,6 +315,16 @@ func (r *UniFFIReader) ReadUint64() uint64 {
 	return val
 }
 
+// ReadInt32 reads a UniFFI-serialized int32 (used for sequence lengths).
+func (r *UniFFIReader) ReadInt32() int32 {
+	if r.offset+4 > len(r.data) {
+		return 0
+	}
+	val := int32(binary.BigEndian.Uint32(r.data[r.offset : r.offset+4]))
+	r.offset += 4
+	return val
+}
+
 // ReadInt8 reads a UniFFI-serialized int8.
 func (r *UniFFIReader) ReadInt8() int8 {
 	if r.offset >= len(r.data) {
@@ -271,3 +334,63 @@ func (r *UniFFIReader) ReadInt8() int8 {
 	r.offset++
 	return val
 }
+
+// remaining returns the number of bytes not yet read.
+func (r *UniFFIReader) remaining() int {
+	return len(r.data) - r.offset
+}
+
+// readCount reads a sequence length, rejecting a missing length, negative
+// lengths and lengths longer than the bytes left to read.
+func (r *UniFFIReader) readCount() (int, bool) {
+	if r.remaining() < 4 {
+		return 0, false
+	}
+	count := r.ReadInt32()
+	if count < 0 || int(count) > r.remaining() {
+		return 0, false
+	}
+	return int(count), true
+}
+
+// readString reads a string, rejecting lengths longer than the bytes left to read.
+func (r *UniFFIReader) readString() (string, bool) {
+	if r.remaining() < 4 {
+		return "", false
+	}
+	if length := binary.BigEndian.Uint32(r.data[r.offset:]); uint64(length) > uint64(r.remaining()-4) {
+		return "", false
+	}
+	return r.ReadString(), true
+}
+
+// decodeCostAndHandle decodes a UniFFI record made of a cost string followed by
+// a single object handle, the layout shared by the Client put/create results.
+// lift wraps the handle. If the record has trailing bytes the handle is still
+// freed through its wrapper, as it is the only reference Rust handed over.
+func decodeCostAndHandle[T interface{ Free() }](data []byte, record string, lift func(unsafe.Pointer) T) (string, T, error) {
+	var zero T
+	if len(data) < 4 {
+		return "", zero, malformedRecord(record, len(data))
+	}
+	size := int(binary.BigEndian.Uint32(data))
+	if len(data)-4-8 < size {
+		return "", zero, malformedRecord(record, len(data))
+	}
+
+	reader := NewUniFFIReader(data)
+	cost := reader.ReadString()
+	object := lift(reader.ReadPointer())
+
+	if reader.remaining() != 0 {
+		object.Free()
+		return "", zero, malformedRecord(record, len(data))
+	}
+
+	return cost, object, nil
+}
+
+// malformedRecord reports a UniFFI record of size bytes that could not be decoded.
+func malformedRecord(record string, size int) error {
+	return fmt.Errorf("%w: %s has %d bytes", ErrMalformedResult, record, size)
+}
@@ -86,8 +86,8 @@ func NewDerivationIndex() (*DerivationIndex, error) {
 	var status C.RustCallStatus
 	handle := C.uniffi_ant_ffi_fn_constructor_derivationindex_random(&status)
 
-	if err := checkStatus(&status, "DerivationIndex.Random"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "DerivationIndex.Random", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newDerivationIndex(handle), nil
@@ -100,8 +100,8 @@ func DerivationIndexFromBytes(data []byte) (*DerivationIndex, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_derivationindex_from_bytes(dataBuffer, &status)
 
-	if err := checkStatus(&status, "DerivationIndex.FromBytes"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "DerivationIndex.FromBytes", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newDerivationIndex(handle), nil
@@ -110,6 +110,7 @@ func DerivationIndexFromBytes(data []byte) (*DerivationIndex, error) {
 func newDerivationIndex(handle unsafe.Pointer) *DerivationIndex {
 	di := &DerivationIndex{handle: handle}
 	runtime.SetFinalizer(di, (*DerivationIndex).Free)
+	trackHandle(unsafe.Pointer(di), "DerivationIndex", di.handle)
 	return di
 }
 
@@ -123,9 +124,16 @@ func (di *DerivationIndex) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_derivationindex(di.handle, &status)
+	untrackHandle(unsafe.Pointer(di))
 	di.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (di *DerivationIndex) Close() error {
+	di.Free()
+	return nil
+}
+
 func (di *DerivationIndex) ToBytes() ([]byte, error) {
 	di.mu.Lock()
 	defer di.mu.Unlock()
@@ -136,10 +144,11 @@ func (di *DerivationIndex) ToBytes() ([]byte, error) {
 
 	cloned := di.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_derivationindex_to_bytes(cloned, &status)
 
-	if err := checkStatus(&status, "DerivationIndex.ToBytes"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "DerivationIndex.ToBytes", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return fromRustBuffer(result, true), nil
@@ -147,7 +156,7 @@ func (di *DerivationIndex) ToBytes() ([]byte, error) {
 
 func (di *DerivationIndex) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_derivationindex(di.handle, &status)
+	return trackClone("DerivationIndex", C.uniffi_ant_ffi_fn_clone_derivationindex(di.handle, &status))
 }
 
 func (di *DerivationIndex) CloneHandle() unsafe.Pointer {
@@ -173,8 +182,8 @@ func SignatureFromBytes(data []byte) (*Signature, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_signature_from_bytes(dataBuffer, &status)
 
-	if err := checkStatus(&status, "Signature.FromBytes"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "Signature.FromBytes", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newSignature(handle), nil
@@ -183,6 +192,7 @@ func SignatureFromBytes(data []byte) (*Signature, error) {
 func newSignature(handle unsafe.Pointer) *Signature {
 	s := &Signature{handle: handle}
 	runtime.SetFinalizer(s, (*Signature).Free)
+	trackHandle(unsafe.Pointer(s), "Signature", s.handle)
 	return s
 }
 
@@ -196,9 +206,16 @@ func (s *Signature) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_signature(s.handle, &status)
+	untrackHandle(unsafe.Pointer(s))
 	s.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (s *Signature) Close() error {
+	s.Free()
+	return nil
+}
+
 func (s *Signature) ToBytes() ([]byte, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -209,10 +226,11 @@ func (s *Signature) ToBytes() ([]byte, error) {
 
 	cloned := s.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_signature_to_bytes(cloned, &status)
 
-	if err := checkStatus(&status, "Signature.ToBytes"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "Signature.ToBytes", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return fromRustBuffer(result, true), nil
@@ -228,10 +246,11 @@ func (s *Signature) ToHex() (string, error) {
 
 	cloned := s.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_signature_to_hex(cloned, &status)
 
-	if err := checkStatus(&status, "Signature.ToHex"); err != nil {
-		return "", &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "Signature.ToHex", liftKeyError); err != nil {
+		return "", err
 	}
 
 	return stringFromRustBuffer(result), nil
@@ -247,10 +266,11 @@ func (s *Signature) Parity() (int8, error) {
 
 	cloned := s.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_signature_parity(cloned, &status)
 
-	if err := checkStatus(&status, "Signature.Parity"); err != nil {
-		return 0, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "Signature.Parity", liftKeyError); err != nil {
+		return 0, err
 	}
 
 	return int8(result), nil
@@ -258,7 +278,7 @@ func (s *Signature) Parity() (int8, error) {
 
 func (s *Signature) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_signature(s.handle, &status)
+	return trackClone("Signature", C.uniffi_ant_ffi_fn_clone_signature(s.handle, &status))
 }
 
 func (s *Signature) CloneHandle() unsafe.Pointer {
@@ -289,10 +309,11 @@ func NewMainSecretKey(sk *SecretKey) (*MainSecretKey, error) {
 	}
 
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_constructor_mainsecretkey_new(cloned, &status)
 
-	if err := checkStatus(&status, "MainSecretKey.New"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "MainSecretKey.New", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newMainSecretKey(handle), nil
@@ -303,8 +324,8 @@ func NewMainSecretKeyRandom() (*MainSecretKey, error) {
 	var status C.RustCallStatus
 	handle := C.uniffi_ant_ffi_fn_constructor_mainsecretkey_random(&status)
 
-	if err := checkStatus(&status, "MainSecretKey.Random"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "MainSecretKey.Random", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newMainSecretKey(handle), nil
@@ -313,6 +334,7 @@ func NewMainSecretKeyRandom() (*MainSecretKey, error) {
 func newMainSecretKey(handle unsafe.Pointer) *MainSecretKey {
 	msk := &MainSecretKey{handle: handle}
 	runtime.SetFinalizer(msk, (*MainSecretKey).Free)
+	trackHandle(unsafe.Pointer(msk), "MainSecretKey", msk.handle)
 	return msk
 }
 
@@ -326,9 +348,16 @@ func (msk *MainSecretKey) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_mainsecretkey(msk.handle, &status)
+	untrackHandle(unsafe.Pointer(msk))
 	msk.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (msk *MainSecretKey) Close() error {
+	msk.Free()
+	return nil
+}
+
 func (msk *MainSecretKey) PublicKey() (*MainPubkey, error) {
 	msk.mu.Lock()
 	defer msk.mu.Unlock()
@@ -339,10 +368,11 @@ func (msk *MainSecretKey) PublicKey() (*MainPubkey, error) {
 
 	cloned := msk.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_method_mainsecretkey_public_key(cloned, &status)
 
-	if err := checkStatus(&status, "MainSecretKey.PublicKey"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "MainSecretKey.PublicKey", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newMainPubkey(handle), nil
@@ -359,10 +389,11 @@ func (msk *MainSecretKey) Sign(msg []byte) (*Signature, error) {
 	cloned := msk.cloneHandle()
 	msgBuffer := toRustBuffer(msg)
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_method_mainsecretkey_sign(cloned, msgBuffer, &status)
 
-	if err := checkStatus(&status, "MainSecretKey.Sign"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "MainSecretKey.Sign", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newSignature(handle), nil
@@ -379,17 +410,18 @@ func (msk *MainSecretKey) DeriveKey(index *DerivationIndex) (*DerivedSecretKey,
 		return nil, ErrInvalidArgument
 	}
 
-	clonedMsk := msk.cloneHandle()
 	clonedIndex := index.CloneHandle()
 	if clonedIndex == nil {
 		return nil, ErrDisposed
 	}
+	clonedMsk := msk.cloneHandle()
 
 	var status C.RustCallStatus
+	consumeClones(clonedMsk, clonedIndex)
 	handle := C.uniffi_ant_ffi_fn_method_mainsecretkey_derive_key(clonedMsk, clonedIndex, &status)
 
-	if err := checkStatus(&status, "MainSecretKey.DeriveKey"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "MainSecretKey.DeriveKey", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newDerivedSecretKey(handle), nil
@@ -405,10 +437,11 @@ func (msk *MainSecretKey) RandomDerivedKey() (*DerivedSecretKey, error) {
 
 	cloned := msk.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_method_mainsecretkey_random_derived_key(cloned, &status)
 
-	if err := checkStatus(&status, "MainSecretKey.RandomDerivedKey"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "MainSecretKey.RandomDerivedKey", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newDerivedSecretKey(handle), nil
@@ -424,10 +457,11 @@ func (msk *MainSecretKey) ToBytes() ([]byte, error) {
 
 	cloned := msk.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_mainsecretkey_to_bytes(cloned, &status)
 
-	if err := checkStatus(&status, "MainSecretKey.ToBytes"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "MainSecretKey.ToBytes", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return fromRustBuffer(result, true), nil
@@ -435,7 +469,7 @@ func (msk *MainSecretKey) ToBytes() ([]byte, error) {
 
 func (msk *MainSecretKey) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_mainsecretkey(msk.handle, &status)
+	return trackClone("MainSecretKey", C.uniffi_ant_ffi_fn_clone_mainsecretkey(msk.handle, &status))
 }
 
 // MainPubkey represents a main public key for key derivation.
@@ -457,10 +491,11 @@ func NewMainPubkey(pk *PublicKey) (*MainPubkey, error) {
 	}
 
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_constructor_mainpubkey_new(cloned, &status)
 
-	if err := checkStatus(&status, "MainPubkey.New"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "MainPubkey.New", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newMainPubkey(handle), nil
@@ -473,8 +508,8 @@ func MainPubkeyFromHex(hex string) (*MainPubkey, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_mainpubkey_from_hex(hexBuffer, &status)
 
-	if err := checkStatus(&status, "MainPubkey.FromHex"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "MainPubkey.FromHex", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newMainPubkey(handle), nil
@@ -483,6 +518,7 @@ func MainPubkeyFromHex(hex string) (*MainPubkey, error) {
 func newMainPubkey(handle unsafe.Pointer) *MainPubkey {
 	mpk := &MainPubkey{handle: handle}
 	runtime.SetFinalizer(mpk, (*MainPubkey).Free)
+	trackHandle(unsafe.Pointer(mpk), "MainPubkey", mpk.handle)
 	return mpk
 }
 
@@ -496,9 +532,16 @@ func (mpk *MainPubkey) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_mainpubkey(mpk.handle, &status)
+	untrackHandle(unsafe.Pointer(mpk))
 	mpk.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (mpk *MainPubkey) Close() error {
+	mpk.Free()
+	return nil
+}
+
 func (mpk *MainPubkey) Verify(sig *Signature, msg []byte) (bool, error) {
 	mpk.mu.Lock()
 	defer mpk.mu.Unlock()
@@ -510,18 +553,19 @@ func (mpk *MainPubkey) Verify(sig *Signature, msg []byte) (bool, error) {
 		return false, ErrInvalidArgument
 	}
 
-	clonedMpk := mpk.cloneHandle()
 	clonedSig := sig.CloneHandle()
 	if clonedSig == nil {
 		return false, ErrDisposed
 	}
+	clonedMpk := mpk.cloneHandle()
 	msgBuffer := toRustBuffer(msg)
 
 	var status C.RustCallStatus
+	consumeClones(clonedMpk, clonedSig)
 	result := C.uniffi_ant_ffi_fn_method_mainpubkey_verify(clonedMpk, clonedSig, msgBuffer, &status)
 
-	if err := checkStatus(&status, "MainPubkey.Verify"); err != nil {
-		return false, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "MainPubkey.Verify", liftClientError); err != nil {
+		return false, err
 	}
 
 	return result != 0, nil
@@ -538,17 +582,18 @@ func (mpk *MainPubkey) DeriveKey(index *DerivationIndex) (*DerivedPubkey, error)
 		return nil, ErrInvalidArgument
 	}
 
-	clonedMpk := mpk.cloneHandle()
 	clonedIndex := index.CloneHandle()
 	if clonedIndex == nil {
 		return nil, ErrDisposed
 	}
+	clonedMpk := mpk.cloneHandle()
 
 	var status C.RustCallStatus
+	consumeClones(clonedMpk, clonedIndex)
 	handle := C.uniffi_ant_ffi_fn_method_mainpubkey_derive_key(clonedMpk, clonedIndex, &status)
 
-	if err := checkStatus(&status, "MainPubkey.DeriveKey"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "MainPubkey.DeriveKey", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newDerivedPubkey(handle), nil
@@ -564,10 +609,11 @@ func (mpk *MainPubkey) ToBytes() ([]byte, error) {
 
 	cloned := mpk.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_mainpubkey_to_bytes(cloned, &status)
 
-	if err := checkStatus(&status, "MainPubkey.ToBytes"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "MainPubkey.ToBytes", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return fromRustBuffer(result, true), nil
@@ -583,10 +629,11 @@ func (mpk *MainPubkey) ToHex() (string, error) {
 
 	cloned := mpk.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_mainpubkey_to_hex(cloned, &status)
 
-	if err := checkStatus(&status, "MainPubkey.ToHex"); err != nil {
-		return "", &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "MainPubkey.ToHex", liftKeyError); err != nil {
+		return "", err
 	}
 
 	return stringFromRustBuffer(result), nil
@@ -594,7 +641,7 @@ func (mpk *MainPubkey) ToHex() (string, error) {
 
 func (mpk *MainPubkey) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_mainpubkey(mpk.handle, &status)
+	return trackClone("MainPubkey", C.uniffi_ant_ffi_fn_clone_mainpubkey(mpk.handle, &status))
 }
 
 // DerivedSecretKey represents a derived secret key.
@@ -616,10 +663,11 @@ func NewDerivedSecretKey(sk *SecretKey) (*DerivedSecretKey, error) {
 	}
 
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_constructor_derivedsecretkey_new(cloned, &status)
 
-	if err := checkStatus(&status, "DerivedSecretKey.New"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "DerivedSecretKey.New", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newDerivedSecretKey(handle), nil
@@ -628,6 +676,7 @@ func NewDerivedSecretKey(sk *SecretKey) (*DerivedSecretKey, error) {
 func newDerivedSecretKey(handle unsafe.Pointer) *DerivedSecretKey {
 	dsk := &DerivedSecretKey{handle: handle}
 	runtime.SetFinalizer(dsk, (*DerivedSecretKey).Free)
+	trackHandle(unsafe.Pointer(dsk), "DerivedSecretKey", dsk.handle)
 	return dsk
 }
 
@@ -641,9 +690,16 @@ func (dsk *DerivedSecretKey) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_derivedsecretkey(dsk.handle, &status)
+	untrackHandle(unsafe.Pointer(dsk))
 	dsk.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (dsk *DerivedSecretKey) Close() error {
+	dsk.Free()
+	return nil
+}
+
 func (dsk *DerivedSecretKey) PublicKey() (*DerivedPubkey, error) {
 	dsk.mu.Lock()
 	defer dsk.mu.Unlock()
@@ -654,10 +710,11 @@ func (dsk *DerivedSecretKey) PublicKey() (*DerivedPubkey, error) {
 
 	cloned := dsk.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_method_derivedsecretkey_public_key(cloned, &status)
 
-	if err := checkStatus(&status, "DerivedSecretKey.PublicKey"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "DerivedSecretKey.PublicKey", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newDerivedPubkey(handle), nil
@@ -674,10 +731,11 @@ func (dsk *DerivedSecretKey) Sign(msg []byte) (*Signature, error) {
 	cloned := dsk.cloneHandle()
 	msgBuffer := toRustBuffer(msg)
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_method_derivedsecretkey_sign(cloned, msgBuffer, &status)
 
-	if err := checkStatus(&status, "DerivedSecretKey.Sign"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "DerivedSecretKey.Sign", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newSignature(handle), nil
@@ -685,7 +743,7 @@ func (dsk *DerivedSecretKey) Sign(msg []byte) (*Signature, error) {
 
 func (dsk *DerivedSecretKey) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_derivedsecretkey(dsk.handle, &status)
+	return trackClone("DerivedSecretKey", C.uniffi_ant_ffi_fn_clone_derivedsecretkey(dsk.handle, &status))
 }
 
 // CloneHandle returns a cloned handle for FFI operations.
@@ -717,10 +775,11 @@ func NewDerivedPubkey(pk *PublicKey) (*DerivedPubkey, error) {
 	}
 
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_constructor_derivedpubkey_new(cloned, &status)
 
-	if err := checkStatus(&status, "DerivedPubkey.New"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "DerivedPubkey.New", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newDerivedPubkey(handle), nil
@@ -733,8 +792,8 @@ func DerivedPubkeyFromHex(hex string) (*DerivedPubkey, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_derivedpubkey_from_hex(hexBuffer, &status)
 
-	if err := checkStatus(&status, "DerivedPubkey.FromHex"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "DerivedPubkey.FromHex", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newDerivedPubkey(handle), nil
@@ -743,6 +802,7 @@ func DerivedPubkeyFromHex(hex string) (*DerivedPubkey, error) {
 func newDerivedPubkey(handle unsafe.Pointer) *DerivedPubkey {
 	dpk := &DerivedPubkey{handle: handle}
 	runtime.SetFinalizer(dpk, (*DerivedPubkey).Free)
+	trackHandle(unsafe.Pointer(dpk), "DerivedPubkey", dpk.handle)
 	return dpk
 }
 
@@ -756,9 +816,16 @@ func (dpk *DerivedPubkey) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_derivedpubkey(dpk.handle, &status)
+	untrackHandle(unsafe.Pointer(dpk))
 	dpk.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (dpk *DerivedPubkey) Close() error {
+	dpk.Free()
+	return nil
+}
+
 func (dpk *DerivedPubkey) Verify(sig *Signature, msg []byte) (bool, error) {
 	dpk.mu.Lock()
 	defer dpk.mu.Unlock()
@@ -770,18 +837,19 @@ func (dpk *DerivedPubkey) Verify(sig *Signature, msg []byte) (bool, error) {
 		return false, ErrInvalidArgument
 	}
 
-	clonedDpk := dpk.cloneHandle()
 	clonedSig := sig.CloneHandle()
 	if clonedSig == nil {
 		return false, ErrDisposed
 	}
+	clonedDpk := dpk.cloneHandle()
 	msgBuffer := toRustBuffer(msg)
 
 	var status C.RustCallStatus
+	consumeClones(clonedDpk, clonedSig)
 	result := C.uniffi_ant_ffi_fn_method_derivedpubkey_verify(clonedDpk, clonedSig, msgBuffer, &status)
 
-	if err := checkStatus(&status, "DerivedPubkey.Verify"); err != nil {
-		return false, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "DerivedPubkey.Verify", liftClientError); err != nil {
+		return false, err
 	}
 
 	return result != 0, nil
@@ -797,10 +865,11 @@ func (dpk *DerivedPubkey) ToBytes() ([]byte, error) {
 
 	cloned := dpk.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_derivedpubkey_to_bytes(cloned, &status)
 
-	if err := checkStatus(&status, "DerivedPubkey.ToBytes"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "DerivedPubkey.ToBytes", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return fromRustBuffer(result, true), nil
@@ -816,10 +885,11 @@ func (dpk *DerivedPubkey) ToHex() (string, error) {
 
 	cloned := dpk.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_derivedpubkey_to_hex(cloned, &status)
 
-	if err := checkStatus(&status, "DerivedPubkey.ToHex"); err != nil {
-		return "", &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "DerivedPubkey.ToHex", liftKeyError); err != nil {
+		return "", err
 	}
 
 	return stringFromRustBuffer(result), nil
@@ -827,5 +897,5 @@ func (dpk *DerivedPubkey) ToHex() (string, error) {
 
 func (dpk *DerivedPubkey) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_derivedpubkey(dpk.handle, &status)
+	return trackClone("DerivedPubkey", C.uniffi_ant_ffi_fn_clone_derivedpubkey(dpk.handle, &status))
 }
@@ -46,8 +46,8 @@ func NewSecretKey() (*SecretKey, error) {
 	var status C.RustCallStatus
 	handle := C.uniffi_ant_ffi_fn_constructor_secretkey_random(&status)
 
-	if err := checkStatus(&status, "SecretKey.Random"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "SecretKey.Random", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newSecretKey(handle), nil
@@ -60,8 +60,8 @@ func SecretKeyFromHex(hex string) (*SecretKey, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_secretkey_from_hex(hexBuffer, &status)
 
-	if err := checkStatus(&status, "SecretKey.FromHex"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "SecretKey.FromHex", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newSecretKey(handle), nil
@@ -70,6 +70,7 @@ func SecretKeyFromHex(hex string) (*SecretKey, error) {
 func newSecretKey(handle unsafe.Pointer) *SecretKey {
 	sk := &SecretKey{handle: handle}
 	runtime.SetFinalizer(sk, (*SecretKey).Free)
+	trackHandle(unsafe.Pointer(sk), "SecretKey", sk.handle)
 	return sk
 }
 
@@ -84,9 +85,16 @@ func (sk *SecretKey) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_secretkey(sk.handle, &status)
+	untrackHandle(unsafe.Pointer(sk))
 	sk.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (sk *SecretKey) Close() error {
+	sk.Free()
+	return nil
+}
+
 // ToHex returns the hex representation of the secret key.
 func (sk *SecretKey) ToHex() (string, error) {
 	sk.mu.Lock()
@@ -98,10 +106,11 @@ func (sk *SecretKey) ToHex() (string, error) {
 
 	cloned := sk.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_secretkey_to_hex(cloned, &status)
 
-	if err := checkStatus(&status, "SecretKey.ToHex"); err != nil {
-		return "", &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "SecretKey.ToHex", liftKeyError); err != nil {
+		return "", err
 	}
 
 	return stringFromRustBuffer(result), nil
@@ -118,10 +127,11 @@ func (sk *SecretKey) PublicKey() (*PublicKey, error) {
 
 	cloned := sk.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_method_secretkey_public_key(cloned, &status)
 
-	if err := checkStatus(&status, "SecretKey.PublicKey"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "SecretKey.PublicKey", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newPublicKey(handle), nil
@@ -130,7 +140,7 @@ func (sk *SecretKey) PublicKey() (*PublicKey, error) {
 // cloneHandle clones the underlying handle for use in FFI calls.
 func (sk *SecretKey) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_secretkey(sk.handle, &status)
+	return trackClone("SecretKey", C.uniffi_ant_ffi_fn_clone_secretkey(sk.handle, &status))
 }
 
 // CloneHandle returns a cloned handle for external use (e.g., passing to other FFI functions).
@@ -158,8 +168,8 @@ func PublicKeyFromHex(hex string) (*PublicKey, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_publickey_from_hex(hexBuffer, &status)
 
-	if err := checkStatus(&status, "PublicKey.FromHex"); err != nil {
-		return nil, &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "PublicKey.FromHex", liftKeyError); err != nil {
+		return nil, err
 	}
 
 	return newPublicKey(handle), nil
@@ -168,6 +178,7 @@ func PublicKeyFromHex(hex string) (*PublicKey, error) {
 func newPublicKey(handle unsafe.Pointer) *PublicKey {
 	pk := &PublicKey{handle: handle}
 	runtime.SetFinalizer(pk, (*PublicKey).Free)
+	trackHandle(unsafe.Pointer(pk), "PublicKey", pk.handle)
 	return pk
 }
 
@@ -182,9 +193,16 @@ func (pk *PublicKey) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_publickey(pk.handle, &status)
+	untrackHandle(unsafe.Pointer(pk))
 	pk.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (pk *PublicKey) Close() error {
+	pk.Free()
+	return nil
+}
+
 // ToHex returns the hex representation of the public key.
 func (pk *PublicKey) ToHex() (string, error) {
 	pk.mu.Lock()
@@ -196,10 +214,11 @@ func (pk *PublicKey) ToHex() (string, error) {
 
 	cloned := pk.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_publickey_to_hex(cloned, &status)
 
-	if err := checkStatus(&status, "PublicKey.ToHex"); err != nil {
-		return "", &KeyError{Wrapped: err}
+	if err := checkStatus(&status, "PublicKey.ToHex", liftKeyError); err != nil {
+		return "", err
 	}
 
 	return stringFromRustBuffer(result), nil
@@ -208,7 +227,7 @@ func (pk *PublicKey) ToHex() (string, error) {
 // cloneHandle clones the underlying handle for use in FFI calls.
 func (pk *PublicKey) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_publickey(pk.handle, &status)
+	return trackClone("PublicKey", C.uniffi_ant_ffi_fn_clone_publickey(pk.handle, &status))
 }
 
 // CloneHandle returns a cloned handle for external use.
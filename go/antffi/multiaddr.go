@@ -0,0 +1,111 @@
+package antffi
+
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+// multiaddrArg describes the kind of value a multiaddr protocol component takes.
+type multiaddrArg int
+
+const (
+	multiaddrArgNone multiaddrArg = iota
+	multiaddrArgIP4
+	multiaddrArgIP6
+	multiaddrArgPort
+	multiaddrArgHost
+	multiaddrArgPeerID
+	multiaddrArgValue
+)
+
+// multiaddrProtocols lists the protocols accepted in bootstrap peer addresses.
+var multiaddrProtocols = map[string]multiaddrArg{
+	"ip4":          multiaddrArgIP4,
+	"ip6":          multiaddrArgIP6,
+	"dns":          multiaddrArgHost,
+	"dns4":         multiaddrArgHost,
+	"dns6":         multiaddrArgHost,
+	"dnsaddr":      multiaddrArgHost,
+	"tcp":          multiaddrArgPort,
+	"udp":          multiaddrArgPort,
+	"quic":         multiaddrArgNone,
+	"quic-v1":      multiaddrArgNone,
+	"ws":           multiaddrArgNone,
+	"wss":          multiaddrArgNone,
+	"tls":          multiaddrArgNone,
+	"webtransport": multiaddrArgNone,
+	"p2p-circuit":  multiaddrArgNone,
+	"certhash":     multiaddrArgValue,
+	"p2p":          multiaddrArgPeerID,
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// validateMultiaddr checks that addr is a well-formed multiaddr string
+// (e.g. "/ip4/10.0.2.2/tcp/12000/p2p/12D3KooW...").
+// Returns a *PeerError describing the first problem found.
+func validateMultiaddr(addr string) error {
+	if addr == "" {
+		return &PeerError{Peer: addr, Reason: "empty address"}
+	}
+	if !strings.HasPrefix(addr, "/") {
+		return &PeerError{Peer: addr, Reason: "must start with '/'"}
+	}
+
+	parts := strings.Split(addr[1:], "/")
+	for i := 0; i < len(parts); i++ {
+		name := parts[i]
+		if name == "" {
+			return &PeerError{Peer: addr, Reason: "empty protocol component"}
+		}
+
+		kind, ok := multiaddrProtocols[name]
+		if !ok {
+			return &PeerError{Peer: addr, Reason: "unsupported protocol " + strconv.Quote(name)}
+		}
+		if kind == multiaddrArgNone {
+			continue
+		}
+
+		i++
+		if i >= len(parts) || parts[i] == "" {
+			return &PeerError{Peer: addr, Reason: "missing value for " + name}
+		}
+		if reason := validateMultiaddrValue(kind, parts[i]); reason != "" {
+			return &PeerError{Peer: addr, Reason: name + ": " + reason}
+		}
+	}
+
+	return nil
+}
+
+// validateMultiaddrValue validates a single protocol value, returning a
+// non-empty reason when it is malformed.
+func validateMultiaddrValue(kind multiaddrArg, value string) string {
+	switch kind {
+	case multiaddrArgIP4:
+		if ip := net.ParseIP(value); ip == nil || ip.To4() == nil || strings.Contains(value, ":") {
+			return "invalid IPv4 address " + strconv.Quote(value)
+		}
+	case multiaddrArgIP6:
+		if ip := net.ParseIP(value); ip == nil || !strings.Contains(value, ":") {
+			return "invalid IPv6 address " + strconv.Quote(value)
+		}
+	case multiaddrArgPort:
+		if port, err := strconv.ParseUint(value, 10, 16); err != nil || port == 0 {
+			return "invalid port " + strconv.Quote(value)
+		}
+	case multiaddrArgHost:
+		if strings.ContainsAny(value, " \t") {
+			return "invalid host name " + strconv.Quote(value)
+		}
+	case multiaddrArgPeerID:
+		for _, r := range value {
+			if !strings.ContainsRune(base58Alphabet, r) {
+				return "invalid peer ID " + strconv.Quote(value)
+			}
+		}
+	}
+	return ""
+}
@@ -47,7 +47,7 @@ func NewNetwork(local bool) (*Network, error) {
 	var status C.RustCallStatus
 	handle := C.uniffi_ant_ffi_fn_constructor_network_new(localFlag, &status)
 
-	if err := checkStatus(&status, "Network.New"); err != nil {
+	if err := checkStatus(&status, "Network.New", liftNetworkError); err != nil {
 		return nil, err
 	}
 
@@ -65,7 +65,7 @@ func NewNetworkCustom(rpcURL, paymentTokenAddress, dataPaymentsAddress string, r
 	var status C.RustCallStatus
 	handle := C.uniffi_ant_ffi_fn_constructor_network_custom(rpcURLBuffer, paymentTokenBuffer, dataPaymentsBuffer, royaltiesBuffer, &status)
 
-	if err := checkStatus(&status, "Network.Custom"); err != nil {
+	if err := checkStatus(&status, "Network.Custom", liftNetworkError); err != nil {
 		return nil, err
 	}
 
@@ -75,6 +75,7 @@ func NewNetworkCustom(rpcURL, paymentTokenAddress, dataPaymentsAddress string, r
 func newNetwork(handle unsafe.Pointer, isLocal bool) *Network {
 	n := &Network{handle: handle, IsLocal: isLocal}
 	runtime.SetFinalizer(n, (*Network).Free)
+	trackHandle(unsafe.Pointer(n), "Network", n.handle)
 	return n
 }
 
@@ -89,12 +90,19 @@ func (n *Network) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_network(n.handle, &status)
+	untrackHandle(unsafe.Pointer(n))
 	n.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (n *Network) Close() error {
+	n.Free()
+	return nil
+}
+
 func (n *Network) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_network(n.handle, &status)
+	return trackClone("Network", C.uniffi_ant_ffi_fn_clone_network(n.handle, &status))
 }
 
 // CloneHandle returns a cloned handle for FFI operations.
@@ -36,12 +36,20 @@ extern void* uniffi_ant_ffi_fn_constructor_networkpointer_new(void* key, uint64_
 extern void* uniffi_ant_ffi_fn_method_networkpointer_address(void* ptr, RustCallStatus* status);
 extern void* uniffi_ant_ffi_fn_method_networkpointer_target(void* ptr, RustCallStatus* status);
 extern uint64_t uniffi_ant_ffi_fn_method_networkpointer_counter(void* ptr, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_constructor_networkpointer_from_bytes(RustBuffer bytes, RustCallStatus* status);
+extern RustBuffer uniffi_ant_ffi_fn_method_networkpointer_to_bytes(void* ptr, RustCallStatus* status);
+extern RustBuffer uniffi_ant_ffi_fn_method_networkpointer_snapshot(void* ptr, RustCallStatus* status);
 extern void uniffi_ant_ffi_fn_free_networkpointer(void* ptr, RustCallStatus* status);
 extern void* uniffi_ant_ffi_fn_clone_networkpointer(void* ptr, RustCallStatus* status);
+
+// Pointer Functions
+extern void uniffi_ant_ffi_fn_func_pointer_verify(void* pointer, RustCallStatus* status);
 */
 import "C"
 
 import (
+	"errors"
+	"fmt"
 	"runtime"
 	"sync"
 	"unsafe"
@@ -66,9 +74,10 @@ func NewPointerAddress(pk *PublicKey) (*PointerAddress, error) {
 	}
 
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_constructor_pointeraddress_new(cloned, &status)
 
-	if err := checkStatus(&status, "PointerAddress.New"); err != nil {
+	if err := checkStatus(&status, "PointerAddress.New", liftPointerError); err != nil {
 		return nil, err
 	}
 
@@ -82,7 +91,7 @@ func PointerAddressFromHex(hex string) (*PointerAddress, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_pointeraddress_from_hex(hexBuffer, &status)
 
-	if err := checkStatus(&status, "PointerAddress.FromHex"); err != nil {
+	if err := checkStatus(&status, "PointerAddress.FromHex", liftPointerError); err != nil {
 		return nil, err
 	}
 
@@ -92,6 +101,7 @@ func PointerAddressFromHex(hex string) (*PointerAddress, error) {
 func newPointerAddress(handle unsafe.Pointer) *PointerAddress {
 	pa := &PointerAddress{handle: handle}
 	runtime.SetFinalizer(pa, (*PointerAddress).Free)
+	trackHandle(unsafe.Pointer(pa), "PointerAddress", pa.handle)
 	return pa
 }
 
@@ -105,9 +115,16 @@ func (pa *PointerAddress) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_pointeraddress(pa.handle, &status)
+	untrackHandle(unsafe.Pointer(pa))
 	pa.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (pa *PointerAddress) Close() error {
+	pa.Free()
+	return nil
+}
+
 func (pa *PointerAddress) Owner() (*PublicKey, error) {
 	pa.mu.Lock()
 	defer pa.mu.Unlock()
@@ -118,9 +135,10 @@ func (pa *PointerAddress) Owner() (*PublicKey, error) {
 
 	cloned := pa.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_method_pointeraddress_owner(cloned, &status)
 
-	if err := checkStatus(&status, "PointerAddress.Owner"); err != nil {
+	if err := checkStatus(&status, "PointerAddress.Owner", liftPointerError); err != nil {
 		return nil, err
 	}
 
@@ -137,9 +155,10 @@ func (pa *PointerAddress) ToHex() (string, error) {
 
 	cloned := pa.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_pointeraddress_to_hex(cloned, &status)
 
-	if err := checkStatus(&status, "PointerAddress.ToHex"); err != nil {
+	if err := checkStatus(&status, "PointerAddress.ToHex", liftPointerError); err != nil {
 		return "", err
 	}
 
@@ -148,7 +167,7 @@ func (pa *PointerAddress) ToHex() (string, error) {
 
 func (pa *PointerAddress) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_pointeraddress(pa.handle, &status)
+	return trackClone("PointerAddress", C.uniffi_ant_ffi_fn_clone_pointeraddress(pa.handle, &status))
 }
 
 func (pa *PointerAddress) CloneHandle() unsafe.Pointer {
@@ -179,9 +198,10 @@ func NewPointerTargetChunk(addr *ChunkAddress) (*PointerTarget, error) {
 	}
 
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_constructor_pointertarget_chunk(cloned, &status)
 
-	if err := checkStatus(&status, "PointerTarget.Chunk"); err != nil {
+	if err := checkStatus(&status, "PointerTarget.Chunk", liftPointerError); err != nil {
 		return nil, err
 	}
 
@@ -200,9 +220,10 @@ func NewPointerTargetPointer(addr *PointerAddress) (*PointerTarget, error) {
 	}
 
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_constructor_pointertarget_pointer(cloned, &status)
 
-	if err := checkStatus(&status, "PointerTarget.Pointer"); err != nil {
+	if err := checkStatus(&status, "PointerTarget.Pointer", liftPointerError); err != nil {
 		return nil, err
 	}
 
@@ -221,9 +242,10 @@ func NewPointerTargetGraphEntry(addr *GraphEntryAddress) (*PointerTarget, error)
 	}
 
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_constructor_pointertarget_graph_entry(cloned, &status)
 
-	if err := checkStatus(&status, "PointerTarget.GraphEntry"); err != nil {
+	if err := checkStatus(&status, "PointerTarget.GraphEntry", liftPointerError); err != nil {
 		return nil, err
 	}
 
@@ -242,9 +264,10 @@ func NewPointerTargetScratchpad(addr *ScratchpadAddress) (*PointerTarget, error)
 	}
 
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_constructor_pointertarget_scratchpad(cloned, &status)
 
-	if err := checkStatus(&status, "PointerTarget.Scratchpad"); err != nil {
+	if err := checkStatus(&status, "PointerTarget.Scratchpad", liftPointerError); err != nil {
 		return nil, err
 	}
 
@@ -254,6 +277,7 @@ func NewPointerTargetScratchpad(addr *ScratchpadAddress) (*PointerTarget, error)
 func newPointerTarget(handle unsafe.Pointer) *PointerTarget {
 	pt := &PointerTarget{handle: handle}
 	runtime.SetFinalizer(pt, (*PointerTarget).Free)
+	trackHandle(unsafe.Pointer(pt), "PointerTarget", pt.handle)
 	return pt
 }
 
@@ -267,9 +291,16 @@ func (pt *PointerTarget) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_pointertarget(pt.handle, &status)
+	untrackHandle(unsafe.Pointer(pt))
 	pt.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (pt *PointerTarget) Close() error {
+	pt.Free()
+	return nil
+}
+
 func (pt *PointerTarget) ToHex() (string, error) {
 	pt.mu.Lock()
 	defer pt.mu.Unlock()
@@ -280,9 +311,10 @@ func (pt *PointerTarget) ToHex() (string, error) {
 
 	cloned := pt.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_pointertarget_to_hex(cloned, &status)
 
-	if err := checkStatus(&status, "PointerTarget.ToHex"); err != nil {
+	if err := checkStatus(&status, "PointerTarget.ToHex", liftPointerError); err != nil {
 		return "", err
 	}
 
@@ -291,7 +323,7 @@ func (pt *PointerTarget) ToHex() (string, error) {
 
 func (pt *PointerTarget) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_pointertarget(pt.handle, &status)
+	return trackClone("PointerTarget", C.uniffi_ant_ffi_fn_clone_pointertarget(pt.handle, &status))
 }
 
 func (pt *PointerTarget) CloneHandle() unsafe.Pointer {
@@ -303,6 +335,62 @@ func (pt *PointerTarget) CloneHandle() unsafe.Pointer {
 	return pt.cloneHandle()
 }
 
+// PointerTargetKind identifies the kind of address a pointer target refers to.
+type PointerTargetKind int32
+
+const (
+	PointerTargetChunk PointerTargetKind = iota + 1
+	PointerTargetPointer
+	PointerTargetGraphEntry
+	PointerTargetScratchpad
+)
+
+func (k PointerTargetKind) String() string {
+	switch k {
+	case PointerTargetChunk:
+		return "chunk"
+	case PointerTargetPointer:
+		return "pointer"
+	case PointerTargetGraphEntry:
+		return "graph entry"
+	case PointerTargetScratchpad:
+		return "scratchpad"
+	}
+	return fmt.Sprintf("PointerTargetKind(%d)", int32(k))
+}
+
+// PointerSnapshot holds every field of a NetworkPointer as plain Go values.
+type PointerSnapshot struct {
+	// Address is the hex-encoded address where the pointer is stored.
+	Address string
+	// Owner is the hex-encoded public key of the owner.
+	Owner string
+	// Counter is the pointer's version.
+	Counter uint64
+	// TargetKind is the kind of address Target refers to.
+	TargetKind PointerTargetKind
+	// Target is the hex-encoded address the pointer points to.
+	Target string
+}
+
+// decodePointerSnapshot decodes a UniFFI-serialized PointerSnapshot record.
+func decodePointerSnapshot(data []byte) (PointerSnapshot, error) {
+	reader := NewUniFFIReader(data)
+	snapshot := PointerSnapshot{
+		Address:    reader.ReadString(),
+		Owner:      reader.ReadString(),
+		Counter:    reader.ReadUint64(),
+		TargetKind: PointerTargetKind(reader.ReadInt32()),
+		Target:     reader.ReadString(),
+	}
+
+	if reader.remaining() != 0 || snapshot.TargetKind < PointerTargetChunk || snapshot.TargetKind > PointerTargetScratchpad {
+		return PointerSnapshot{}, malformedRecord("PointerSnapshot", len(data))
+	}
+
+	return snapshot, nil
+}
+
 // NetworkPointer represents a pointer on the network.
 type NetworkPointer struct {
 	handle unsafe.Pointer
@@ -317,15 +405,35 @@ func NewNetworkPointer(key *SecretKey, counter uint64, target *PointerTarget) (*
 	}
 
 	clonedKey := key.CloneHandle()
+	if clonedKey == nil {
+		return nil, ErrDisposed
+	}
 	clonedTarget := target.CloneHandle()
-	if clonedKey == nil || clonedTarget == nil {
+	if clonedTarget == nil {
+		consumeClones(clonedKey)
+		newSecretKey(clonedKey).Free()
 		return nil, ErrDisposed
 	}
 
 	var status C.RustCallStatus
+	consumeClones(clonedKey, clonedTarget)
 	handle := C.uniffi_ant_ffi_fn_constructor_networkpointer_new(clonedKey, C.uint64_t(counter), clonedTarget, &status)
 
-	if err := checkStatus(&status, "NetworkPointer.New"); err != nil {
+	if err := checkStatus(&status, "NetworkPointer.New", liftPointerError); err != nil {
+		return nil, err
+	}
+
+	return newNetworkPointer(handle), nil
+}
+
+// NetworkPointerFromBytes decodes a pointer produced by NetworkPointer.ToBytes.
+// The signature is not checked; call Verify before trusting the pointer.
+func NetworkPointerFromBytes(data []byte) (*NetworkPointer, error) {
+	dataBuffer := toRustBuffer(data)
+	var status C.RustCallStatus
+	handle := C.uniffi_ant_ffi_fn_constructor_networkpointer_from_bytes(dataBuffer, &status)
+
+	if err := checkStatus(&status, "NetworkPointer.FromBytes", liftPointerError); err != nil {
 		return nil, err
 	}
 
@@ -335,6 +443,7 @@ func NewNetworkPointer(key *SecretKey, counter uint64, target *PointerTarget) (*
 func newNetworkPointer(handle unsafe.Pointer) *NetworkPointer {
 	np := &NetworkPointer{handle: handle}
 	runtime.SetFinalizer(np, (*NetworkPointer).Free)
+	trackHandle(unsafe.Pointer(np), "NetworkPointer", np.handle)
 	return np
 }
 
@@ -348,9 +457,16 @@ func (np *NetworkPointer) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_networkpointer(np.handle, &status)
+	untrackHandle(unsafe.Pointer(np))
 	np.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (np *NetworkPointer) Close() error {
+	np.Free()
+	return nil
+}
+
 func (np *NetworkPointer) Address() (*PointerAddress, error) {
 	np.mu.Lock()
 	defer np.mu.Unlock()
@@ -361,9 +477,10 @@ func (np *NetworkPointer) Address() (*PointerAddress, error) {
 
 	cloned := np.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_method_networkpointer_address(cloned, &status)
 
-	if err := checkStatus(&status, "NetworkPointer.Address"); err != nil {
+	if err := checkStatus(&status, "NetworkPointer.Address", liftPointerError); err != nil {
 		return nil, err
 	}
 
@@ -380,9 +497,10 @@ func (np *NetworkPointer) Target() (*PointerTarget, error) {
 
 	cloned := np.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_method_networkpointer_target(cloned, &status)
 
-	if err := checkStatus(&status, "NetworkPointer.Target"); err != nil {
+	if err := checkStatus(&status, "NetworkPointer.Target", liftPointerError); err != nil {
 		return nil, err
 	}
 
@@ -399,18 +517,89 @@ func (np *NetworkPointer) Counter() (uint64, error) {
 
 	cloned := np.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_networkpointer_counter(cloned, &status)
 
-	if err := checkStatus(&status, "NetworkPointer.Counter"); err != nil {
+	if err := checkStatus(&status, "NetworkPointer.Counter", liftPointerError); err != nil {
 		return 0, err
 	}
 
 	return uint64(result), nil
 }
 
+// Snapshot returns every field of the pointer in a single FFI call, without
+// allocating a handle per field. Prefer it to Address, Target and Counter
+// when reading many pointers.
+func (np *NetworkPointer) Snapshot() (PointerSnapshot, error) {
+	np.mu.Lock()
+	defer np.mu.Unlock()
+
+	if np.freed {
+		return PointerSnapshot{}, ErrDisposed
+	}
+
+	cloned := np.cloneHandle()
+	var status C.RustCallStatus
+	consumeClones(cloned)
+	result := C.uniffi_ant_ffi_fn_method_networkpointer_snapshot(cloned, &status)
+
+	if err := checkStatus(&status, "NetworkPointer.Snapshot", liftPointerError); err != nil {
+		return PointerSnapshot{}, err
+	}
+
+	return decodePointerSnapshot(fromRustBufferRaw(result, true))
+}
+
+// ToBytes encodes the pointer, including its signature, for caching or relaying.
+func (np *NetworkPointer) ToBytes() ([]byte, error) {
+	np.mu.Lock()
+	defer np.mu.Unlock()
+
+	if np.freed {
+		return nil, ErrDisposed
+	}
+
+	cloned := np.cloneHandle()
+	var status C.RustCallStatus
+	consumeClones(cloned)
+	result := C.uniffi_ant_ffi_fn_method_networkpointer_to_bytes(cloned, &status)
+
+	if err := checkStatus(&status, "NetworkPointer.ToBytes", liftPointerError); err != nil {
+		return nil, err
+	}
+
+	return fromRustBuffer(result, true), nil
+}
+
+// Verify checks that the pointer is signed by its owner.
+// Returns a *VerificationError if the signature is invalid.
+func (np *NetworkPointer) Verify() error {
+	np.mu.Lock()
+	defer np.mu.Unlock()
+
+	if np.freed {
+		return ErrDisposed
+	}
+
+	cloned := np.cloneHandle()
+	var status C.RustCallStatus
+	consumeClones(cloned)
+	C.uniffi_ant_ffi_fn_func_pointer_verify(cloned, &status)
+
+	if err := checkStatus(&status, "NetworkPointer.Verify", liftClientError); err != nil {
+		var clientErr *ClientError
+		if errors.As(err, &clientErr) {
+			return &VerificationError{Kind: "pointer", Reason: clientErr.Reason}
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (np *NetworkPointer) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_networkpointer(np.handle, &status)
+	return trackClone("NetworkPointer", C.uniffi_ant_ffi_fn_clone_networkpointer(np.handle, &status))
 }
 
 func (np *NetworkPointer) CloneHandle() unsafe.Pointer {
@@ -0,0 +1,352 @@
+package antffi
+
+/*
+#include <stdint.h>
+#include <stdlib.h>
+
+// Guarded because _cgo_export.h includes the preamble of every file with //export.
+#ifndef ANT_FFI_RUST_TYPES
+#define ANT_FFI_RUST_TYPES
+typedef struct {
+    uint64_t capacity;
+    uint64_t len;
+    uint8_t* data;
+} RustBuffer;
+
+typedef struct {
+    int8_t code;
+    RustBuffer error_buf;
+} RustCallStatus;
+#endif
+
+// ProgressListener callback interface
+typedef void (*UniffiCallbackInterfaceProgressListenerMethod0)(uint64_t uniffi_handle, RustBuffer event, void* uniffi_out_return, RustCallStatus* uniffi_out_call_status);
+typedef void (*UniffiCallbackInterfaceFree)(uint64_t handle);
+typedef struct {
+    UniffiCallbackInterfaceProgressListenerMethod0 on_event;
+    UniffiCallbackInterfaceFree uniffi_free;
+} UniffiVTableCallbackInterfaceProgressListener;
+
+extern void uniffi_ant_ffi_fn_init_callback_vtable_progresslistener(UniffiVTableCallbackInterfaceProgressListener* vtable);
+
+// Go callbacks that will be called from Rust
+void goProgressOnEvent(uint64_t uniffi_handle, RustBuffer event, void* uniffi_out_return, RustCallStatus* uniffi_out_call_status);
+void goProgressFree(uint64_t handle);
+*/
+import "C"
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+)
+
+// DefaultProgressInterval is the minimum time between ProgressTransferred
+// reports when TransferOptions.ProgressInterval is zero.
+const DefaultProgressInterval = 100 * time.Millisecond
+
+// ProgressKind identifies the step of a transfer a Progress report is for.
+type ProgressKind int32
+
+const (
+	// ProgressStarted is reported once, with the totals set.
+	ProgressStarted ProgressKind = iota + 1
+	// ProgressFileStarted is reported when work on File begins.
+	ProgressFileStarted
+	// ProgressTransferred is reported as data moves. It is throttled.
+	ProgressTransferred
+	// ProgressFileFinished is reported when File is done.
+	ProgressFileFinished
+	// ProgressPaymentMade is reported after uploaded data is paid for, with Cost set.
+	ProgressPaymentMade
+	// ProgressFinished is reported once, when the transfer succeeds.
+	ProgressFinished
+)
+
+func (k ProgressKind) String() string {
+	switch k {
+	case ProgressStarted:
+		return "Started"
+	case ProgressFileStarted:
+		return "FileStarted"
+	case ProgressTransferred:
+		return "Transferred"
+	case ProgressFileFinished:
+		return "FileFinished"
+	case ProgressPaymentMade:
+		return "PaymentMade"
+	case ProgressFinished:
+		return "Finished"
+	default:
+		return fmt.Sprintf("ProgressKind(%d)", int32(k))
+	}
+}
+
+// Progress is the state of a transfer when a step is reported.
+//
+// Downloads count every chunk as it is decrypted. The network client does not
+// report upload progress per chunk, so uploads report its upload events as
+// they arrive: each one is a ProgressPaymentMade with the tokens spent, then a
+// ProgressTransferred adding the chunks stored but no bytes. BytesDone reaches
+// BytesTotal in one step, when the upload returns. ChunksTotal is estimated
+// from the data size.
+type Progress struct {
+	Kind ProgressKind
+
+	BytesDone   uint64
+	BytesTotal  uint64
+	ChunksDone  uint64
+	ChunksTotal uint64
+	FilesDone   uint64
+	FilesTotal  uint64 // zero for DataPut
+
+	File string // the file being transferred, if any
+	Cost string // the amount paid, for ProgressPaymentMade
+}
+
+// ProgressFunc receives progress reports. Reports for one transfer arrive in
+// order on a single goroutine, and all of them are delivered before the
+// transfer method returns.
+type ProgressFunc func(Progress)
+
+// TransferOptions configures progress reporting for DataPut, FileUpload,
+// FileDownload, DirUpload and DirDownload.
+type TransferOptions struct {
+	// Progress, if set, receives progress reports.
+	Progress ProgressFunc
+
+	// ProgressInterval is the minimum time between ProgressTransferred
+	// reports. Zero means DefaultProgressInterval. Other kinds are never dropped.
+	ProgressInterval time.Duration
+}
+
+// resolveTransferOptions returns the last of opts, or the zero options if none are given.
+func resolveTransferOptions(opts []TransferOptions) TransferOptions {
+	if len(opts) == 0 {
+		return TransferOptions{}
+	}
+	return opts[len(opts)-1]
+}
+
+// progressEvent is a decoded Rust ProgressEvent. Byte, chunk and file counts
+// are increments; totals are only set by ProgressStarted.
+type progressEvent struct {
+	kind        ProgressKind
+	totalBytes  uint64
+	totalChunks uint64
+	totalFiles  uint64
+	path        string
+	bytes       uint64
+	chunks      uint64
+	cost        string
+}
+
+// decodeProgressEvent decodes a UniFFI-serialized ProgressEvent enum.
+func decodeProgressEvent(data []byte) (progressEvent, error) {
+	reader := NewUniFFIReader(data)
+	event := progressEvent{kind: ProgressKind(reader.ReadInt32())}
+
+	switch event.kind {
+	case ProgressStarted:
+		event.totalBytes = reader.ReadUint64()
+		event.totalChunks = reader.ReadUint64()
+		event.totalFiles = reader.ReadUint64()
+	case ProgressFileStarted:
+		event.path = reader.ReadString()
+		event.bytes = reader.ReadUint64()
+	case ProgressTransferred:
+		event.bytes = reader.ReadUint64()
+		event.chunks = reader.ReadUint64()
+	case ProgressFileFinished:
+		event.path = reader.ReadString()
+	case ProgressPaymentMade:
+		event.cost = reader.ReadString()
+	case ProgressFinished:
+	default:
+		return progressEvent{}, malformedRecord("ProgressEvent", len(data))
+	}
+
+	if reader.remaining() != 0 {
+		return progressEvent{}, malformedRecord("ProgressEvent", len(data))
+	}
+	return event, nil
+}
+
+// apply folds event into the transfer state p.
+func (p *Progress) apply(event progressEvent) {
+	p.Kind = event.kind
+	p.Cost = ""
+
+	switch event.kind {
+	case ProgressStarted:
+		// A retried transfer starts again from zero.
+		*p = Progress{
+			Kind:        ProgressStarted,
+			BytesTotal:  event.totalBytes,
+			ChunksTotal: event.totalChunks,
+			FilesTotal:  event.totalFiles,
+		}
+	case ProgressFileStarted:
+		p.File = event.path
+	case ProgressTransferred:
+		p.BytesDone += event.bytes
+		p.ChunksDone += event.chunks
+	case ProgressFileFinished:
+		p.File = event.path
+		p.FilesDone++
+	case ProgressPaymentMade:
+		p.Cost = event.cost
+	case ProgressFinished:
+		p.File = ""
+	}
+}
+
+// progressReporter turns the events of one transfer into Progress reports
+// and delivers them to a ProgressFunc on its own goroutine, so a slow
+// callback never stalls the Rust thread doing the transfer.
+type progressReporter struct {
+	fn       ProgressFunc
+	interval time.Duration
+
+	mu     sync.Mutex
+	state  Progress
+	queue  []Progress
+	last   time.Time // when the last ProgressTransferred report was queued
+	closed bool
+
+	wake chan struct{}
+	done chan struct{}
+}
+
+// newProgressReporter starts a reporter for opts, or returns nil if opts
+// has no ProgressFunc.
+func newProgressReporter(opts []TransferOptions) *progressReporter {
+	options := resolveTransferOptions(opts)
+	if options.Progress == nil {
+		return nil
+	}
+	interval := options.ProgressInterval
+	if interval <= 0 {
+		interval = DefaultProgressInterval
+	}
+
+	p := &progressReporter{
+		fn:       options.Progress,
+		interval: interval,
+		wake:     make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	go p.deliver()
+	return p
+}
+
+// report records event and queues a report, unless it is a
+// ProgressTransferred event within the throttle interval.
+func (p *progressReporter) report(event progressEvent) {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.state.apply(event)
+	if event.kind == ProgressTransferred {
+		now := time.Now()
+		if now.Sub(p.last) < p.interval {
+			p.mu.Unlock()
+			return
+		}
+		p.last = now
+	}
+	p.queue = append(p.queue, p.state)
+	p.mu.Unlock()
+
+	p.signal()
+}
+
+func (p *progressReporter) signal() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// deliver passes queued reports to the ProgressFunc until the reporter is closed.
+func (p *progressReporter) deliver() {
+	defer close(p.done)
+	for {
+		p.mu.Lock()
+		batch, closed := p.queue, p.closed
+		p.queue = nil
+		p.mu.Unlock()
+
+		for _, progress := range batch {
+			p.fn(progress)
+		}
+		if closed {
+			return
+		}
+		<-p.wake
+	}
+}
+
+// close stops accepting events and waits until every queued report is delivered.
+func (p *progressReporter) close() {
+	p.mu.Lock()
+	p.closed = true
+	p.mu.Unlock()
+
+	p.signal()
+	<-p.done
+}
+
+// Reporters handed to Rust, keyed by the callback handle Rust holds.
+var (
+	progressCounter    uint64
+	progressReporters  sync.Map // map[uint64]*progressReporter
+	progressVTableOnce sync.Once
+)
+
+// lower registers p for a Rust ProgressListener argument and returns its
+// handle. Rust releases the handle through goProgressFree.
+func (p *progressReporter) lower() C.uint64_t {
+	progressVTableOnce.Do(registerProgressVTable)
+	id := atomic.AddUint64(&progressCounter, 1)
+	progressReporters.Store(id, p)
+	return C.uint64_t(id)
+}
+
+// registerProgressVTable gives Rust the Go implementation of ProgressListener.
+// The vtable lives in C memory because Rust keeps it for the life of the process.
+func registerProgressVTable() {
+	vtable := (*C.UniffiVTableCallbackInterfaceProgressListener)(C.malloc(C.sizeof_UniffiVTableCallbackInterfaceProgressListener))
+	vtable.on_event = C.UniffiCallbackInterfaceProgressListenerMethod0(C.goProgressOnEvent)
+	vtable.uniffi_free = C.UniffiCallbackInterfaceFree(C.goProgressFree)
+	C.uniffi_ant_ffi_fn_init_callback_vtable_progresslistener(vtable)
+}
+
+//export goProgressOnEvent
+func goProgressOnEvent(handle C.uint64_t, event C.RustBuffer, outReturn unsafe.Pointer, status *C.RustCallStatus) {
+	status.code = 0
+	dispatchProgressEvent(uint64(handle), fromRustBufferRaw(event, true))
+}
+
+//export goProgressFree
+func goProgressFree(handle C.uint64_t) {
+	progressReporters.Delete(uint64(handle))
+}
+
+// dispatchProgressEvent passes a serialized event to the reporter registered
+// under handle. Events for unknown handles and malformed events are dropped.
+func dispatchProgressEvent(handle uint64, data []byte) {
+	val, ok := progressReporters.Load(handle)
+	if !ok {
+		return
+	}
+	event, err := decodeProgressEvent(data)
+	if err != nil {
+		return
+	}
+	val.(*progressReporter).report(event)
+}
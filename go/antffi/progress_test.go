@@ -0,0 +1,288 @@
+package antffi
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func startedEvent(bytes, chunks, files uint64) []byte {
+	var w recordWriter
+	w.int32(int32(ProgressStarted)).uint64(bytes).uint64(chunks).uint64(files)
+	return w.Bytes()
+}
+
+func fileStartedEvent(path string, bytes uint64) []byte {
+	var w recordWriter
+	w.int32(int32(ProgressFileStarted)).string(path).uint64(bytes)
+	return w.Bytes()
+}
+
+func transferredEvent(bytes, chunks uint64) []byte {
+	var w recordWriter
+	w.int32(int32(ProgressTransferred)).uint64(bytes).uint64(chunks)
+	return w.Bytes()
+}
+
+func fileFinishedEvent(path string) []byte {
+	var w recordWriter
+	w.int32(int32(ProgressFileFinished)).string(path)
+	return w.Bytes()
+}
+
+func paymentEvent(cost string) []byte {
+	var w recordWriter
+	w.int32(int32(ProgressPaymentMade)).string(cost)
+	return w.Bytes()
+}
+
+func finishedEvent() []byte {
+	var w recordWriter
+	w.int32(int32(ProgressFinished))
+	return w.Bytes()
+}
+
+func TestDecodeProgressEvent(t *testing.T) {
+	cases := []struct {
+		data []byte
+		want progressEvent
+	}{
+		{startedEvent(100, 3, 2), progressEvent{kind: ProgressStarted, totalBytes: 100, totalChunks: 3, totalFiles: 2}},
+		{fileStartedEvent("a.txt", 60), progressEvent{kind: ProgressFileStarted, path: "a.txt", bytes: 60}},
+		{transferredEvent(10, 1), progressEvent{kind: ProgressTransferred, bytes: 10, chunks: 1}},
+		{fileFinishedEvent("a.txt"), progressEvent{kind: ProgressFileFinished, path: "a.txt"}},
+		{paymentEvent("42"), progressEvent{kind: ProgressPaymentMade, cost: "42"}},
+		{finishedEvent(), progressEvent{kind: ProgressFinished}},
+	}
+	for _, tc := range cases {
+		got, err := decodeProgressEvent(tc.data)
+		if err != nil {
+			t.Fatalf("decodeProgressEvent(%v) failed: %v", tc.want.kind, err)
+		}
+		if got != tc.want {
+			t.Fatalf("decodeProgressEvent = %+v, want %+v", got, tc.want)
+		}
+	}
+}
+
+func TestDecodeProgressEventMalformed(t *testing.T) {
+	var unknown recordWriter
+	unknown.int32(7)
+
+	cases := map[string][]byte{
+		"empty":           nil,
+		"unknown variant": unknown.Bytes(),
+		"truncated":       transferredEvent(10, 1)[:10],
+		"trailing bytes":  append(finishedEvent(), 0),
+	}
+	for name, data := range cases {
+		if _, err := decodeProgressEvent(data); !errors.Is(err, ErrMalformedResult) {
+			t.Errorf("%s: expected ErrMalformedResult, got %v", name, err)
+		}
+	}
+}
+
+func TestProgressApply(t *testing.T) {
+	var p Progress
+	for _, data := range [][]byte{
+		startedEvent(100, 6, 2),
+		fileStartedEvent("a.txt", 60),
+		transferredEvent(60, 3),
+		fileFinishedEvent("a.txt"),
+		fileStartedEvent("b.txt", 40),
+		paymentEvent("7"),
+	} {
+		event, err := decodeProgressEvent(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.apply(event)
+	}
+
+	want := Progress{
+		Kind:        ProgressPaymentMade,
+		BytesDone:   60,
+		BytesTotal:  100,
+		ChunksDone:  3,
+		ChunksTotal: 6,
+		FilesDone:   1,
+		FilesTotal:  2,
+		File:        "b.txt",
+		Cost:        "7",
+	}
+	if p != want {
+		t.Fatalf("Progress = %+v, want %+v", p, want)
+	}
+
+	// A retry starts again from zero.
+	event, _ := decodeProgressEvent(startedEvent(100, 6, 2))
+	p.apply(event)
+	if p != (Progress{Kind: ProgressStarted, BytesTotal: 100, ChunksTotal: 6, FilesTotal: 2}) {
+		t.Fatalf("Progress after restart = %+v", p)
+	}
+}
+
+// collectProgress returns a reporter and a function returning what it delivered.
+func collectProgress(interval time.Duration) (*progressReporter, func() []Progress) {
+	var mu sync.Mutex
+	var got []Progress
+	p := newProgressReporter([]TransferOptions{{
+		Progress: func(progress Progress) {
+			mu.Lock()
+			got = append(got, progress)
+			mu.Unlock()
+		},
+		ProgressInterval: interval,
+	}})
+	return p, func() []Progress {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]Progress(nil), got...)
+	}
+}
+
+func reportAll(t *testing.T, p *progressReporter, events ...[]byte) {
+	t.Helper()
+	for _, data := range events {
+		event, err := decodeProgressEvent(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.report(event)
+	}
+}
+
+func TestProgressReporterThrottle(t *testing.T) {
+	p, delivered := collectProgress(time.Hour)
+
+	events := [][]byte{startedEvent(1000, 1000, 1)}
+	for i := 0; i < 1000; i++ {
+		events = append(events, transferredEvent(1, 1))
+	}
+	events = append(events, finishedEvent())
+	reportAll(t, p, events...)
+	p.close()
+
+	got := delivered()
+	kinds := make([]ProgressKind, len(got))
+	for i, progress := range got {
+		kinds[i] = progress.Kind
+	}
+	want := []ProgressKind{ProgressStarted, ProgressTransferred, ProgressFinished}
+	if !reflect.DeepEqual(kinds, want) {
+		t.Fatalf("Delivered %v, want %v", kinds, want)
+	}
+	if last := got[len(got)-1]; last.BytesDone != 1000 || last.ChunksDone != 1000 {
+		t.Fatalf("Final report has %d bytes and %d chunks, want 1000 of each", last.BytesDone, last.ChunksDone)
+	}
+}
+
+func TestProgressReporterKeepsMilestones(t *testing.T) {
+	p, delivered := collectProgress(time.Hour)
+	reportAll(t, p,
+		startedEvent(20, 6, 2),
+		fileStartedEvent("a", 10),
+		paymentEvent("1"),
+		transferredEvent(10, 3),
+		fileFinishedEvent("a"),
+		fileStartedEvent("b", 10),
+		paymentEvent("2"),
+		transferredEvent(10, 3),
+		fileFinishedEvent("b"),
+		finishedEvent(),
+	)
+	p.close()
+
+	got := delivered()
+	var files []string
+	var costs []string
+	for _, progress := range got {
+		switch progress.Kind {
+		case ProgressFileStarted:
+			files = append(files, progress.File)
+		case ProgressPaymentMade:
+			costs = append(costs, progress.Cost)
+		}
+	}
+	if !reflect.DeepEqual(files, []string{"a", "b"}) || !reflect.DeepEqual(costs, []string{"1", "2"}) {
+		t.Fatalf("Delivered files %v and costs %v", files, costs)
+	}
+	if last := got[len(got)-1]; last.Kind != ProgressFinished || last.FilesDone != 2 || last.BytesDone != 20 {
+		t.Fatalf("Unexpected final report %+v", last)
+	}
+}
+
+func TestProgressReporterSlowCallback(t *testing.T) {
+	release := make(chan struct{})
+	var delivered int
+	p := newProgressReporter([]TransferOptions{{Progress: func(Progress) {
+		<-release
+		delivered++
+	}}})
+
+	// Reporting never waits for the callback.
+	done := make(chan struct{})
+	go func() {
+		reportAll(t, p, startedEvent(1, 1, 0), paymentEvent("1"), finishedEvent())
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("report blocked on a slow callback")
+	}
+
+	// close waits until every report is delivered.
+	time.AfterFunc(10*time.Millisecond, func() { close(release) })
+	p.close()
+	if delivered != 3 {
+		t.Fatalf("Delivered %d reports before close returned, want 3", delivered)
+	}
+
+	// Events after close are dropped.
+	reportAll(t, p, finishedEvent())
+}
+
+func TestDispatchProgressEvent(t *testing.T) {
+	p, delivered := collectProgress(0)
+	handle := uint64(p.lower())
+	defer progressReporters.Delete(handle)
+
+	dispatchProgressEvent(handle, startedEvent(5, 3, 0))
+	dispatchProgressEvent(handle, []byte{0xff})
+	dispatchProgressEvent(handle+1, finishedEvent())
+	p.close()
+
+	if got := delivered(); len(got) != 1 || got[0].Kind != ProgressStarted || got[0].BytesTotal != 5 {
+		t.Fatalf("Delivered %+v, want only the started report", got)
+	}
+}
+
+func TestNewProgressReporterDisabled(t *testing.T) {
+	if p := newProgressReporter(nil); p != nil {
+		t.Fatal("Expected no reporter without options")
+	}
+	if p := newProgressReporter([]TransferOptions{{ProgressInterval: time.Second}}); p != nil {
+		t.Fatal("Expected no reporter without a ProgressFunc")
+	}
+}
+
+func TestTransferProgressDisposedClient(t *testing.T) {
+	c := &Client{}
+	opts := TransferOptions{Progress: func(Progress) { t.Error("Unexpected progress report") }}
+	if _, err := c.DataPut(context.Background(), []byte("data"), nil, opts); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed, got %v", err)
+	}
+	if _, err := c.DirUpload(context.Background(), "dir", &Wallet{}, opts); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed, got %v", err)
+	}
+}
+
+func TestProgressKindString(t *testing.T) {
+	if ProgressPaymentMade.String() != "PaymentMade" || ProgressKind(0).String() != "ProgressKind(0)" {
+		t.Fatalf("Unexpected String: %s, %s", ProgressPaymentMade, ProgressKind(0))
+	}
+}
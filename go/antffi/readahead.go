@@ -0,0 +1,200 @@
+package antffi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultReadAheadBlockSize is the read-ahead block size used when
+// StreamOptions.ReadAheadBlockSize is zero. It matches the maximum
+// self-encryption chunk size.
+const DefaultReadAheadBlockSize = 4 << 20
+
+// StreamOptions configures a DataStream opened by Client.DataStream or
+// Client.DataStreamPublic.
+type StreamOptions struct {
+	// ReadAhead is how many blocks NextChunk fetches concurrently in the
+	// background ahead of the caller. Blocks are returned in order. Zero
+	// disables read-ahead, so NextChunk fetches each chunk on the caller's
+	// goroutine.
+	ReadAhead int
+
+	// ReadAheadBlockSize is the size of each block fetched ahead. Zero means
+	// DefaultReadAheadBlockSize.
+	ReadAheadBlockSize int
+
+	// ReadAheadMemory caps the bytes held by blocks that are being fetched or
+	// are waiting for NextChunk, lowering ReadAhead (and the block size, if
+	// needed) to fit. Zero means no cap beyond ReadAhead blocks.
+	ReadAheadMemory int64
+}
+
+// resolveStreamOptions returns the last of opts, or the zero options if none are given.
+func resolveStreamOptions(opts []StreamOptions) StreamOptions {
+	if len(opts) == 0 {
+		return StreamOptions{}
+	}
+	return opts[len(opts)-1]
+}
+
+// readAheadWindow returns how many blocks of what size may be held at once.
+func (o StreamOptions) readAheadWindow() (window, blockSize int) {
+	blockSize = o.ReadAheadBlockSize
+	if blockSize <= 0 {
+		blockSize = DefaultReadAheadBlockSize
+	}
+	window = o.ReadAhead
+	if o.ReadAheadMemory > 0 && int64(window)*int64(blockSize) > o.ReadAheadMemory {
+		window = int(o.ReadAheadMemory / int64(blockSize))
+		if window < 1 {
+			window, blockSize = 1, int(o.ReadAheadMemory)
+		}
+	}
+	return window, blockSize
+}
+
+// ReadAheadStats reports how well a stream's read-ahead kept ahead of NextChunk.
+type ReadAheadStats struct {
+	Hits    uint64 // NextChunk calls whose block was already fetched
+	Misses  uint64 // NextChunk calls that had to wait for their block
+	Fetched uint64 // blocks fetched so far, including ones not yet read
+}
+
+// readAheadBlock is the outcome of fetching one block.
+type readAheadBlock struct {
+	data []byte
+	err  error
+}
+
+// readAhead fetches consecutive blocks of a source on background goroutines
+// and hands them out in order.
+//
+// A slot is taken before a block is fetched and given back when next hands the
+// block out, so at most window blocks are in flight or buffered at any time.
+type readAhead struct {
+	src    dataSource
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	queue    chan chan readAheadBlock // pending blocks, in order
+	slots    chan struct{}
+	complete atomic.Bool // every block has been queued
+
+	mu  sync.Mutex // serializes next and guards err
+	err error
+
+	hits, misses, fetched atomic.Uint64
+}
+
+// startReadAhead begins fetching size bytes from src in blocks of blockSize,
+// keeping at most window blocks in memory. Fetching stops when ctx is done or
+// stop is called.
+func startReadAhead(ctx context.Context, src dataSource, size uint64, window, blockSize int) *readAhead {
+	ctx, cancel := context.WithCancel(ctx)
+	ra := &readAhead{
+		src:    src,
+		ctx:    ctx,
+		cancel: cancel,
+		queue:  make(chan chan readAheadBlock, window),
+		slots:  make(chan struct{}, window),
+	}
+	go ra.dispatch(size, uint64(blockSize))
+	return ra
+}
+
+// dispatch starts a fetch for each block in turn as slots become free.
+func (ra *readAhead) dispatch(size, blockSize uint64) {
+	defer close(ra.queue)
+
+	for start := uint64(0); start < size; start += blockSize {
+		select {
+		case ra.slots <- struct{}{}:
+		case <-ra.ctx.Done():
+			return
+		}
+
+		length := size - start
+		if length > blockSize {
+			length = blockSize
+		}
+		result := make(chan readAheadBlock, 1)
+		// Never blocks: the queue holds no more blocks than there are slots.
+		ra.queue <- result
+		go ra.fetch(start, length, result)
+	}
+	ra.complete.Store(true)
+}
+
+// fetch reads one block into result.
+func (ra *readAhead) fetch(start, length uint64, result chan<- readAheadBlock) {
+	data, err := ra.src.GetRange(start, length)
+	if err == nil && uint64(len(data)) < length {
+		err = fmt.Errorf("read-ahead block at %d: %w", start, io.ErrUnexpectedEOF)
+	}
+	ra.fetched.Add(1)
+	result <- readAheadBlock{data: data, err: err}
+}
+
+// next returns the next block, or nil once every block has been returned.
+// After an error, every later call returns the same error.
+func (ra *readAhead) next() ([]byte, error) {
+	ra.mu.Lock()
+	defer ra.mu.Unlock()
+
+	if ra.err != nil {
+		return nil, ra.err
+	}
+	data, err := ra.receive()
+	if err != nil {
+		ra.err = err
+		ra.cancel()
+	}
+	return data, err
+}
+
+func (ra *readAhead) receive() ([]byte, error) {
+	var result chan readAheadBlock
+	select {
+	case r, ok := <-ra.queue:
+		if !ok {
+			if !ra.complete.Load() {
+				return nil, contextError(ra.ctx)
+			}
+			return nil, nil
+		}
+		result = r
+	case <-ra.ctx.Done():
+		return nil, contextError(ra.ctx)
+	}
+
+	var block readAheadBlock
+	select {
+	case block = <-result:
+		ra.hits.Add(1)
+	default:
+		ra.misses.Add(1)
+		select {
+		case block = <-result:
+		case <-ra.ctx.Done():
+			return nil, contextError(ra.ctx)
+		}
+	}
+	<-ra.slots
+	return block.data, block.err
+}
+
+// stop cancels fetching. Fetches already running finish in the background.
+func (ra *readAhead) stop() {
+	ra.cancel()
+}
+
+func (ra *readAhead) stats() ReadAheadStats {
+	return ReadAheadStats{
+		Hits:    ra.hits.Load(),
+		Misses:  ra.misses.Load(),
+		Fetched: ra.fetched.Load(),
+	}
+}
@@ -0,0 +1,315 @@
+package antffi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+	"unsafe"
+)
+
+// gatedStream is a fakeStream whose GetRange calls wait for a release, so tests
+// control when blocks finish fetching.
+type gatedStream struct {
+	*fakeStream
+	gate chan struct{}
+
+	mu       sync.Mutex
+	inFlight int
+	peak     int
+}
+
+func newGatedStream(size int) *gatedStream {
+	return &gatedStream{fakeStream: newFakeStream(size, size), gate: make(chan struct{})}
+}
+
+func (g *gatedStream) GetRange(start, length uint64) ([]byte, error) {
+	g.mu.Lock()
+	g.inFlight++
+	if g.inFlight > g.peak {
+		g.peak = g.inFlight
+	}
+	g.mu.Unlock()
+	defer func() {
+		g.mu.Lock()
+		g.inFlight--
+		g.mu.Unlock()
+	}()
+
+	<-g.gate
+	return g.fakeStream.GetRange(start, length)
+}
+
+// waitFetched waits until ra has fetched n blocks.
+func waitFetched(t *testing.T, ra *readAhead, n uint64) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for ra.stats().Fetched < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("Fetched %d blocks, want %d", ra.stats().Fetched, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func readAll(t *testing.T, ra *readAhead) []byte {
+	t.Helper()
+	var out bytes.Buffer
+	for {
+		block, err := ra.next()
+		if err != nil {
+			t.Fatalf("next failed: %v", err)
+		}
+		if block == nil {
+			return out.Bytes()
+		}
+		out.Write(block)
+	}
+}
+
+func TestReadAheadInOrder(t *testing.T) {
+	f := newFakeStream(10_000, 0)
+	ra := startReadAhead(context.Background(), f, uint64(len(f.data)), 4, 1000)
+	defer ra.stop()
+
+	if got := readAll(t, ra); !bytes.Equal(got, f.data) {
+		t.Fatal("Read-ahead returned different data")
+	}
+	if block, err := ra.next(); block != nil || err != nil {
+		t.Fatalf("next after the end = %d bytes, %v", len(block), err)
+	}
+	if s := ra.stats(); s.Fetched != 10 || s.Hits+s.Misses != 10 {
+		t.Fatalf("Unexpected stats %+v", s)
+	}
+}
+
+func TestReadAheadConcurrentFetches(t *testing.T) {
+	g := newGatedStream(8000)
+	ra := startReadAhead(context.Background(), g, 8000, 4, 1000)
+	defer ra.stop()
+
+	// All four slots are fetched at once before any block is released.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		g.mu.Lock()
+		inFlight := g.inFlight
+		g.mu.Unlock()
+		if inFlight == 4 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d fetches in flight, want 4", inFlight)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(g.gate)
+
+	if got := readAll(t, ra); !bytes.Equal(got, g.data) {
+		t.Fatal("Read-ahead returned different data")
+	}
+	if g.peak > 4 {
+		t.Fatalf("%d fetches were in flight, want at most 4", g.peak)
+	}
+}
+
+func TestReadAheadMemoryCap(t *testing.T) {
+	f := newFakeStream(100_000, 0)
+	const window, blockSize = 3, 1000
+	ra := startReadAhead(context.Background(), f, uint64(len(f.data)), window, blockSize)
+	defer ra.stop()
+
+	// With nobody reading, fetching stops once the window is full.
+	waitFetched(t, ra, window)
+	time.Sleep(20 * time.Millisecond)
+	if s := ra.stats(); s.Fetched != window {
+		t.Fatalf("Fetched %d blocks without a reader, want %d", s.Fetched, window)
+	}
+
+	// Each block read frees room for exactly one more.
+	for i := 1; i <= 5; i++ {
+		if _, err := ra.next(); err != nil {
+			t.Fatalf("next failed: %v", err)
+		}
+		waitFetched(t, ra, uint64(window+i))
+		time.Sleep(5 * time.Millisecond)
+		if s := ra.stats(); s.Fetched != uint64(window+i) {
+			t.Fatalf("Fetched %d blocks after reading %d, want %d", s.Fetched, i, window+i)
+		}
+	}
+}
+
+func TestReadAheadHitsAndMisses(t *testing.T) {
+	g := newGatedStream(4000)
+	ra := startReadAhead(context.Background(), g, 4000, 2, 1000)
+	defer ra.stop()
+
+	// The first block is still being fetched, so reading it is a miss.
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(g.gate)
+	}()
+	if _, err := ra.next(); err != nil {
+		t.Fatalf("next failed: %v", err)
+	}
+	if s := ra.stats(); s.Misses != 1 || s.Hits != 0 {
+		t.Fatalf("Expected 1 miss, got %+v", s)
+	}
+
+	// Once the window is fetched, reading it is a hit.
+	waitFetched(t, ra, 3)
+	if _, err := ra.next(); err != nil {
+		t.Fatalf("next failed: %v", err)
+	}
+	if s := ra.stats(); s.Hits != 1 {
+		t.Fatalf("Expected 1 hit, got %+v", s)
+	}
+}
+
+func TestReadAheadCancel(t *testing.T) {
+	g := newGatedStream(4000)
+	defer close(g.gate)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ra := startReadAhead(ctx, g, 4000, 2, 1000)
+
+	time.AfterFunc(10*time.Millisecond, cancel)
+	if _, err := ra.next(); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if _, err := ra.next(); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected the error to stick, got %v", err)
+	}
+}
+
+func TestReadAheadError(t *testing.T) {
+	f := newFakeStream(4000, 0)
+	f.rangeErr = &ClientError{Kind: ClientNetworkError, Reason: "unreachable"}
+	ra := startReadAhead(context.Background(), f, 4000, 2, 1000)
+	defer ra.stop()
+
+	if _, err := ra.next(); !errors.Is(err, ErrNetwork) {
+		t.Fatalf("Expected network error, got %v", err)
+	}
+	if _, err := ra.next(); !errors.Is(err, ErrNetwork) {
+		t.Fatalf("Expected the error to stick, got %v", err)
+	}
+}
+
+func TestReadAheadWindow(t *testing.T) {
+	cases := []struct {
+		options           StreamOptions
+		window, blockSize int
+	}{
+		{StreamOptions{ReadAhead: 4}, 4, DefaultReadAheadBlockSize},
+		{StreamOptions{ReadAhead: 4, ReadAheadBlockSize: 1000}, 4, 1000},
+		{StreamOptions{ReadAhead: 4, ReadAheadBlockSize: 1000, ReadAheadMemory: 2500}, 2, 1000},
+		{StreamOptions{ReadAhead: 4, ReadAheadBlockSize: 1000, ReadAheadMemory: 600}, 1, 600},
+		{StreamOptions{ReadAhead: 4, ReadAheadBlockSize: 1000, ReadAheadMemory: 1 << 20}, 4, 1000},
+	}
+	for _, tc := range cases {
+		window, blockSize := tc.options.readAheadWindow()
+		if window != tc.window || blockSize != tc.blockSize {
+			t.Errorf("%+v: window %d of %d bytes, want %d of %d", tc.options, window, blockSize, tc.window, tc.blockSize)
+		}
+	}
+}
+
+func TestDataStreamReadAheadDisposed(t *testing.T) {
+	c := &Client{}
+	if _, err := c.DataStreamPublic(context.Background(), nil, StreamOptions{ReadAhead: 4}); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed, got %v", err)
+	}
+	if s := (&DataStream{}).ReadAheadStats(); s != (ReadAheadStats{}) {
+		t.Fatalf("Expected zero stats without read-ahead, got %+v", s)
+	}
+}
+
+// newGatedDataStream returns a DataStream with a fake native handle whose
+// ranges are read from g. It reports how often the handle was freed.
+func newGatedDataStream(t *testing.T, g *gatedStream) (*DataStream, func() int) {
+	t.Helper()
+
+	var mu sync.Mutex
+	frees := 0
+	saved := dataStreamOps
+	dataStreamOps.clone = func(handle unsafe.Pointer) (unsafe.Pointer, error) { return handle, nil }
+	dataStreamOps.free = func(unsafe.Pointer) {
+		mu.Lock()
+		defer mu.Unlock()
+		frees++
+	}
+	dataStreamOps.getRange = func(_ unsafe.Pointer, start, length uint64) ([]byte, error) {
+		return g.GetRange(start, length)
+	}
+	t.Cleanup(func() { dataStreamOps = saved })
+
+	return &DataStream{handle: unsafe.Pointer(new(byte))}, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return frees
+	}
+}
+
+// waitInFlight waits until n ranges of g are being fetched at once.
+func waitInFlight(t *testing.T, g *gatedStream, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		g.mu.Lock()
+		inFlight := g.inFlight
+		g.mu.Unlock()
+		if inFlight == n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d ranges in flight, want %d", inFlight, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestDataStreamReadAheadOverlaps(t *testing.T) {
+	g := newGatedStream(8000)
+	s, _ := newGatedDataStream(t, g)
+
+	ra := startReadAhead(context.Background(), s, 8000, 4, 1000)
+	defer ra.stop()
+
+	// The stream's GetRange does not serialize the read-ahead's fetches.
+	waitInFlight(t, g, 4)
+	close(g.gate)
+
+	if got := readAll(t, ra); !bytes.Equal(got, g.data) {
+		t.Fatal("Read-ahead returned different data")
+	}
+}
+
+func TestDataStreamFreeDuringGetRange(t *testing.T) {
+	g := newGatedStream(1000)
+	s, frees := newGatedDataStream(t, g)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := s.GetRange(0, 100)
+		done <- err
+	}()
+	waitInFlight(t, g, 1)
+
+	s.Free()
+	if n := frees(); n != 0 {
+		t.Fatal("Free released the handle with a range in flight")
+	}
+	if _, err := s.GetRange(0, 100); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed after Free, got %v", err)
+	}
+
+	close(g.gate)
+	if err := <-done; err != nil {
+		t.Fatalf("In-flight GetRange failed: %v", err)
+	}
+	if n := frees(); n != 1 {
+		t.Fatalf("Expected the handle freed once after the range returned, got %d", n)
+	}
+}
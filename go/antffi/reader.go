@@ -0,0 +1,268 @@
+package antffi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// dataReaderBlockSize is how much WriteTo requests per GetRange call when it
+// cannot use the chunk iterator. It matches the maximum self-encryption chunk size.
+const dataReaderBlockSize = 4 << 20
+
+// dataSource is the part of DataStream a DataReader reads through.
+type dataSource interface {
+	DataSize() (uint64, error)
+	GetRange(start, length uint64) ([]byte, error)
+	NextChunk() ([]byte, error)
+}
+
+// DataReader reads network data through the standard io interfaces, so it can
+// be passed to io.Copy, http.ServeContent, zip.NewReader or image.Decode.
+//
+// Random access is served by DataStream.GetRange. io.Copy from the start of the
+// data uses WriteTo, which streams chunks with DataStream.NextChunk instead on
+// readers opened by the Client.
+//
+// ReadAt is safe for concurrent use. Read, Seek and WriteTo share the reader's
+// offset and are serialized.
+type DataReader struct {
+	src    dataSource
+	size   int64
+	closer io.Closer
+
+	mu       sync.Mutex // guards offset and iterated
+	offset   int64
+	iterated bool // the chunk iterator may have been used and cannot be rewound
+}
+
+// NewDataReader returns a reader over stream. The stream stays owned by the
+// caller, and Close on the reader does not free it.
+//
+// The caller may already have advanced the stream's chunk iterator, so WriteTo
+// on this reader always fetches with GetRange.
+func NewDataReader(stream *DataStream) (*DataReader, error) {
+	if stream == nil {
+		return nil, ErrNilPointer
+	}
+	return newBorrowedReader(stream)
+}
+
+// newBorrowedReader returns a reader over a caller-owned source whose chunk
+// iterator is never used.
+func newBorrowedReader(src dataSource) (*DataReader, error) {
+	r, err := newDataReader(src, nil)
+	if err != nil {
+		return nil, err
+	}
+	r.iterated = true
+	return r, nil
+}
+
+func newDataReader(src dataSource, closer io.Closer) (*DataReader, error) {
+	size, err := src.DataSize()
+	if err != nil {
+		return nil, err
+	}
+	if int64(size) < 0 {
+		return nil, fmt.Errorf("%w: data size %d overflows int64", ErrMalformedResult, size)
+	}
+	return &DataReader{src: src, size: int64(size), closer: closer}, nil
+}
+
+// OpenReader opens a reader over private data. Close the reader to free the
+// underlying stream. options configure the stream as for DataStream.
+func (c *Client) OpenReader(ctx context.Context, dataMap *DataMapChunk, options ...StreamOptions) (*DataReader, error) {
+	stream, err := c.DataStream(ctx, dataMap, options...)
+	if err != nil {
+		return nil, err
+	}
+	return openStreamReader(stream)
+}
+
+// OpenReaderPublic opens a reader over public data. Close the reader to free
+// the underlying stream. options configure the stream as for DataStreamPublic.
+func (c *Client) OpenReaderPublic(ctx context.Context, address *DataAddress, options ...StreamOptions) (*DataReader, error) {
+	stream, err := c.DataStreamPublic(ctx, address, options...)
+	if err != nil {
+		return nil, err
+	}
+	return openStreamReader(stream)
+}
+
+// openStreamReader wraps a stream in a reader that owns it.
+func openStreamReader(stream *DataStream) (*DataReader, error) {
+	r, err := newDataReader(stream, stream)
+	if err != nil {
+		stream.Free()
+		return nil, err
+	}
+	return r, nil
+}
+
+// Size returns the length of the data in bytes.
+func (r *DataReader) Size() int64 {
+	return r.size
+}
+
+// Read implements io.Reader.
+func (r *DataReader) Read(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+
+	n, err := r.readAt(p, r.offset)
+	r.offset += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return n, err
+}
+
+// ReadAt implements io.ReaderAt. It does not use or change the reader's offset.
+func (r *DataReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("%w: negative offset %d", ErrInvalidArgument, off)
+	}
+	return r.readAt(p, off)
+}
+
+// readAt fills p from off, returning io.EOF if the data ends first.
+func (r *DataReader) readAt(p []byte, off int64) (int, error) {
+	if off >= r.size {
+		return 0, io.EOF
+	}
+
+	want := int64(len(p))
+	if remaining := r.size - off; want > remaining {
+		want = remaining
+	}
+	if want == 0 {
+		return 0, nil
+	}
+
+	data, err := r.src.GetRange(uint64(off), uint64(want))
+	if err != nil {
+		return 0, err
+	}
+
+	n := copy(p, data)
+	if int64(n) < want {
+		return n, io.ErrUnexpectedEOF
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Seek implements io.Seeker. Seeking past the end is allowed; reads there
+// return io.EOF.
+func (r *DataReader) Seek(offset int64, whence int) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, fmt.Errorf("%w: invalid whence %d", ErrInvalidArgument, whence)
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("%w: negative position %d", ErrInvalidArgument, offset)
+	}
+
+	r.offset = offset
+	return offset, nil
+}
+
+// WriteTo implements io.WriterTo, writing the data from the current offset to w.
+//
+// From the start of the data, on a reader opened by the Client, it streams
+// chunk by chunk with NextChunk. The chunk iterator cannot be rewound, so
+// later calls, calls from any other offset and calls on a reader from
+// NewDataReader fetch blocks with GetRange.
+func (r *DataReader) WriteTo(w io.Writer) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.offset == 0 && !r.iterated {
+		r.iterated = true
+		return r.writeChunks(w)
+	}
+	return r.writeRanges(w)
+}
+
+// writeChunks writes every chunk from the chunk iterator to w.
+func (r *DataReader) writeChunks(w io.Writer) (int64, error) {
+	var written int64
+	for r.offset < r.size {
+		chunk, err := r.src.NextChunk()
+		if err != nil {
+			return written, err
+		}
+		if chunk == nil {
+			return written, io.ErrUnexpectedEOF
+		}
+		if remaining := r.size - r.offset; int64(len(chunk)) > remaining {
+			chunk = chunk[:remaining]
+		}
+
+		n, err := w.Write(chunk)
+		written += int64(n)
+		r.offset += int64(n)
+		if err != nil {
+			return written, err
+		}
+		if n < len(chunk) {
+			return written, io.ErrShortWrite
+		}
+	}
+	return written, nil
+}
+
+// writeRanges writes the data from the current offset to w in GetRange blocks.
+func (r *DataReader) writeRanges(w io.Writer) (int64, error) {
+	var written int64
+	for r.offset < r.size {
+		length := r.size - r.offset
+		if length > dataReaderBlockSize {
+			length = dataReaderBlockSize
+		}
+
+		block, err := r.src.GetRange(uint64(r.offset), uint64(length))
+		if err != nil {
+			return written, err
+		}
+		if int64(len(block)) < length {
+			return written, io.ErrUnexpectedEOF
+		}
+
+		n, err := w.Write(block[:length])
+		written += int64(n)
+		r.offset += int64(n)
+		if err != nil {
+			return written, err
+		}
+		if int64(n) < length {
+			return written, io.ErrShortWrite
+		}
+	}
+	return written, nil
+}
+
+// Close frees the underlying stream if the reader was opened by the Client.
+// A reader from NewDataReader leaves its stream to the caller.
+func (r *DataReader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close()
+}
@@ -0,0 +1,307 @@
+package antffi
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"sync"
+	"testing"
+	"testing/iotest"
+)
+
+// fakeStream is an in-memory dataSource that records how it is read.
+type fakeStream struct {
+	mu        sync.Mutex
+	data      []byte
+	chunkSize int
+	next      int // offset of the next chunk from NextChunk
+
+	rangeCalls int
+	chunkCalls int
+	rangeErr   error
+}
+
+func newFakeStream(size, chunkSize int) *fakeStream {
+	data := make([]byte, size)
+	rand.New(rand.NewSource(int64(size))).Read(data)
+	return &fakeStream{data: data, chunkSize: chunkSize}
+}
+
+func (f *fakeStream) DataSize() (uint64, error) {
+	return uint64(len(f.data)), nil
+}
+
+func (f *fakeStream) GetRange(start, length uint64) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.rangeCalls++
+	if f.rangeErr != nil {
+		return nil, f.rangeErr
+	}
+	end := start + length
+	if end > uint64(len(f.data)) {
+		end = uint64(len(f.data))
+	}
+	return append([]byte(nil), f.data[start:end]...), nil
+}
+
+func (f *fakeStream) NextChunk() ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.chunkCalls++
+	if f.next >= len(f.data) {
+		return nil, nil
+	}
+	end := f.next + f.chunkSize
+	if end > len(f.data) {
+		end = len(f.data)
+	}
+	chunk := append([]byte(nil), f.data[f.next:end]...)
+	f.next = end
+	return chunk, nil
+}
+
+func newTestReader(t *testing.T, f *fakeStream) *DataReader {
+	t.Helper()
+	r, err := newDataReader(f, nil)
+	if err != nil {
+		t.Fatalf("newDataReader failed: %v", err)
+	}
+	return r
+}
+
+func TestDataReaderIOTest(t *testing.T) {
+	f := newFakeStream(10_000, 1024)
+	if err := iotest.TestReader(newTestReader(t, f), f.data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDataReaderReadAll(t *testing.T) {
+	f := newFakeStream(5000, 1024)
+	got, err := io.ReadAll(iotest.OneByteReader(newTestReader(t, f)))
+	if err != nil {
+		t.Fatalf("ReadAll failed: %v", err)
+	}
+	if !bytes.Equal(got, f.data) {
+		t.Fatal("ReadAll returned different data")
+	}
+}
+
+func TestDataReaderReadAt(t *testing.T) {
+	f := newFakeStream(100, 16)
+	r := newTestReader(t, f)
+
+	buf := make([]byte, 10)
+	if n, err := r.ReadAt(buf, 40); n != 10 || err != nil || !bytes.Equal(buf, f.data[40:50]) {
+		t.Fatalf("ReadAt(40) = %d, %v", n, err)
+	}
+	if n, err := r.ReadAt(buf, 95); n != 5 || err != io.EOF || !bytes.Equal(buf[:5], f.data[95:]) {
+		t.Fatalf("ReadAt(95) = %d, %v; want 5, EOF", n, err)
+	}
+	if n, err := r.ReadAt(buf, 100); n != 0 || err != io.EOF {
+		t.Fatalf("ReadAt(100) = %d, %v; want 0, EOF", n, err)
+	}
+	if _, err := r.ReadAt(buf, -1); !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("Expected ErrInvalidArgument for negative offset, got %v", err)
+	}
+}
+
+func TestDataReaderSeek(t *testing.T) {
+	f := newFakeStream(100, 16)
+	r := newTestReader(t, f)
+
+	cases := []struct {
+		offset int64
+		whence int
+		want   int64
+	}{
+		{10, io.SeekStart, 10},
+		{5, io.SeekCurrent, 15},
+		{-20, io.SeekEnd, 80},
+		{50, io.SeekEnd, 150},
+	}
+	for _, tc := range cases {
+		pos, err := r.Seek(tc.offset, tc.whence)
+		if err != nil || pos != tc.want {
+			t.Fatalf("Seek(%d, %d) = %d, %v; want %d", tc.offset, tc.whence, pos, err, tc.want)
+		}
+	}
+
+	if n, err := r.Read(make([]byte, 1)); n != 0 || err != io.EOF {
+		t.Fatalf("Read past end = %d, %v; want 0, EOF", n, err)
+	}
+	if _, err := r.Seek(-1, io.SeekStart); !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("Expected ErrInvalidArgument for negative position, got %v", err)
+	}
+	if _, err := r.Seek(0, 7); !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("Expected ErrInvalidArgument for bad whence, got %v", err)
+	}
+}
+
+func TestDataReaderWriteToStreamsChunks(t *testing.T) {
+	f := newFakeStream(10_000, 1024)
+	r := newTestReader(t, f)
+
+	var out bytes.Buffer
+	n, err := io.Copy(&out, r)
+	if err != nil || n != int64(len(f.data)) {
+		t.Fatalf("io.Copy = %d, %v", n, err)
+	}
+	if !bytes.Equal(out.Bytes(), f.data) {
+		t.Fatal("io.Copy returned different data")
+	}
+	if f.rangeCalls != 0 || f.chunkCalls == 0 {
+		t.Fatalf("Expected chunk iteration only, got %d range and %d chunk calls", f.rangeCalls, f.chunkCalls)
+	}
+
+	// The iterator is spent; a second copy after rewinding falls back to ranges.
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	out.Reset()
+	if _, err := io.Copy(&out, r); err != nil {
+		t.Fatalf("Second io.Copy failed: %v", err)
+	}
+	if !bytes.Equal(out.Bytes(), f.data) || f.rangeCalls == 0 {
+		t.Fatalf("Second copy mismatch or no range calls (%d)", f.rangeCalls)
+	}
+}
+
+func TestDataReaderWriteToFromOffset(t *testing.T) {
+	f := newFakeStream(10_000, 1024)
+	r := newTestReader(t, f)
+
+	if _, err := r.Seek(1234, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if _, err := r.WriteTo(&out); err != nil {
+		t.Fatalf("WriteTo failed: %v", err)
+	}
+	if !bytes.Equal(out.Bytes(), f.data[1234:]) {
+		t.Fatal("WriteTo returned different data")
+	}
+	if f.chunkCalls != 0 {
+		t.Fatalf("WriteTo from an offset used the chunk iterator (%d calls)", f.chunkCalls)
+	}
+}
+
+func TestBorrowedReaderWriteToUsesRanges(t *testing.T) {
+	f := newFakeStream(10_000, 1024)
+
+	// The caller has already taken a chunk from its stream.
+	if _, err := f.NextChunk(); err != nil {
+		t.Fatal(err)
+	}
+	r, err := newBorrowedReader(f)
+	if err != nil {
+		t.Fatalf("newBorrowedReader failed: %v", err)
+	}
+
+	var out bytes.Buffer
+	if _, err := io.Copy(&out, r); err != nil {
+		t.Fatalf("io.Copy failed: %v", err)
+	}
+	if !bytes.Equal(out.Bytes(), f.data) {
+		t.Fatal("io.Copy returned different data")
+	}
+	if f.chunkCalls != 1 || f.rangeCalls == 0 {
+		t.Fatalf("Expected range calls only, got %d range and %d chunk calls", f.rangeCalls, f.chunkCalls-1)
+	}
+}
+
+func TestDataReaderError(t *testing.T) {
+	f := newFakeStream(100, 16)
+	f.rangeErr = &ClientError{Kind: ClientNetworkError, Reason: "unreachable"}
+	r := newTestReader(t, f)
+
+	if _, err := r.Read(make([]byte, 10)); !errors.Is(err, ErrNetwork) {
+		t.Fatalf("Expected network error, got %v", err)
+	}
+	if pos, _ := r.Seek(0, io.SeekCurrent); pos != 0 {
+		t.Fatalf("Failed read moved the offset to %d", pos)
+	}
+}
+
+func TestDataReaderConcurrentReadAt(t *testing.T) {
+	f := newFakeStream(64<<10, 4096)
+	r := newTestReader(t, f)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 16; g++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			rng := rand.New(rand.NewSource(seed))
+			for i := 0; i < 200; i++ {
+				off := rng.Int63n(r.Size())
+				buf := make([]byte, rng.Intn(8192)+1)
+				n, err := r.ReadAt(buf, off)
+				if err != nil && err != io.EOF {
+					t.Errorf("ReadAt(%d) failed: %v", off, err)
+					return
+				}
+				if !bytes.Equal(buf[:n], f.data[off:off+int64(n)]) {
+					t.Errorf("ReadAt(%d) returned different data", off)
+					return
+				}
+			}
+		}(int64(g))
+	}
+
+	// Sequential reads share the stream with the ReadAt calls.
+	got, err := io.ReadAll(r)
+	if err != nil || !bytes.Equal(got, f.data) {
+		t.Errorf("Concurrent ReadAll mismatch: %v", err)
+	}
+	wg.Wait()
+}
+
+func TestDataReaderZip(t *testing.T) {
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	w, err := zw.Create("hello.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte("hello from the network")); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f := &fakeStream{data: archive.Bytes(), chunkSize: 64}
+	r := newTestReader(t, f)
+
+	zr, err := zip.NewReader(r, r.Size())
+	if err != nil {
+		t.Fatalf("zip.NewReader failed: %v", err)
+	}
+	rc, err := zr.File[0].Open()
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(rc)
+	if err != nil || string(content) != "hello from the network" {
+		t.Fatalf("Unexpected zip content %q, %v", content, err)
+	}
+}
+
+func TestClientOpenReaderDisposed(t *testing.T) {
+	c := &Client{}
+	if _, err := c.OpenReaderPublic(context.Background(), nil); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed, got %v", err)
+	}
+	if _, err := NewDataReader(nil); !errors.Is(err, ErrNilPointer) {
+		t.Fatalf("Expected ErrNilPointer, got %v", err)
+	}
+}
@@ -0,0 +1,38 @@
+package antffi
+
+// callRefs counts the calls in flight on a wrapper's native handle.
+//
+// Wrappers whose methods release their lock before crossing into Rust (the
+// async Client and Wallet calls) use it so that Free can reject new calls
+// immediately while deferring the release of the native handle until the
+// calls already running have returned. It is guarded by the owner's mutex.
+type callRefs struct {
+	closed   bool
+	inflight int
+}
+
+// begin registers a new call. It fails with ErrDisposed once closed.
+func (r *callRefs) begin() error {
+	if r.closed {
+		return ErrDisposed
+	}
+	r.inflight++
+	return nil
+}
+
+// end finishes a call started by begin and reports whether the native handle
+// should now be released.
+func (r *callRefs) end() bool {
+	r.inflight--
+	return r.closed && r.inflight == 0
+}
+
+// close rejects further calls and reports whether the native handle should be
+// released now. It reports false if already closed.
+func (r *callRefs) close() bool {
+	if r.closed {
+		return false
+	}
+	r.closed = true
+	return r.inflight == 0
+}
@@ -0,0 +1,199 @@
+package antffi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+)
+
+// Every wrapper type can be closed through io.Closer.
+var (
+	_ io.Closer = (*Metadata)(nil)
+	_ io.Closer = (*ArchiveAddress)(nil)
+	_ io.Closer = (*PrivateArchiveDataMap)(nil)
+	_ io.Closer = (*PublicArchiveFileEntry)(nil)
+	_ io.Closer = (*PrivateArchiveFileEntry)(nil)
+	_ io.Closer = (*PublicArchive)(nil)
+	_ io.Closer = (*PrivateArchive)(nil)
+	_ io.Closer = (*Client)(nil)
+	_ io.Closer = (*Chunk)(nil)
+	_ io.Closer = (*ChunkAddress)(nil)
+	_ io.Closer = (*DataAddress)(nil)
+	_ io.Closer = (*DataMapChunk)(nil)
+	_ io.Closer = (*GraphEntryAddress)(nil)
+	_ io.Closer = (*GraphEntry)(nil)
+	_ io.Closer = (*DerivationIndex)(nil)
+	_ io.Closer = (*Signature)(nil)
+	_ io.Closer = (*MainSecretKey)(nil)
+	_ io.Closer = (*MainPubkey)(nil)
+	_ io.Closer = (*DerivedSecretKey)(nil)
+	_ io.Closer = (*DerivedPubkey)(nil)
+	_ io.Closer = (*SecretKey)(nil)
+	_ io.Closer = (*PublicKey)(nil)
+	_ io.Closer = (*Network)(nil)
+	_ io.Closer = (*PointerAddress)(nil)
+	_ io.Closer = (*PointerTarget)(nil)
+	_ io.Closer = (*NetworkPointer)(nil)
+	_ io.Closer = (*RegisterAddress)(nil)
+	_ io.Closer = (*ScratchpadAddress)(nil)
+	_ io.Closer = (*Scratchpad)(nil)
+	_ io.Closer = (*DataStream)(nil)
+	_ io.Closer = (*VaultSecretKey)(nil)
+	_ io.Closer = (*UserData)(nil)
+	_ io.Closer = (*Wallet)(nil)
+)
+
+func TestCallRefsReleaseWhenIdle(t *testing.T) {
+	var r callRefs
+	if !r.close() {
+		t.Fatal("Closing with no calls in flight should release")
+	}
+	if r.close() {
+		t.Fatal("Second close should not release again")
+	}
+	if err := r.begin(); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed after close, got %v", err)
+	}
+}
+
+func TestCallRefsReleaseAfterDrain(t *testing.T) {
+	var r callRefs
+	for i := 0; i < 3; i++ {
+		if err := r.begin(); err != nil {
+			t.Fatalf("begin: %v", err)
+		}
+	}
+
+	if r.close() {
+		t.Fatal("Close released the handle with calls in flight")
+	}
+	if err := r.begin(); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed for a call after close, got %v", err)
+	}
+
+	if r.end() || r.end() {
+		t.Fatal("Handle released before the last call returned")
+	}
+	if !r.end() {
+		t.Fatal("Handle not released when the last call returned")
+	}
+}
+
+func TestCallRefsNoCloseNoRelease(t *testing.T) {
+	var r callRefs
+	_ = r.begin()
+	if r.end() {
+		t.Fatal("Handle released without close")
+	}
+}
+
+// TestCallRefsConcurrentClose races calls against close and checks that the
+// handle is released exactly once, never while a call holds it.
+func TestCallRefsConcurrentClose(t *testing.T) {
+	for round := 0; round < 50; round++ {
+		var (
+			mu       sync.Mutex
+			r        callRefs
+			released int
+			inUse    int
+			wg       sync.WaitGroup
+		)
+
+		call := func() {
+			mu.Lock()
+			if r.begin() != nil {
+				mu.Unlock()
+				return
+			}
+			inUse++
+			mu.Unlock()
+
+			mu.Lock()
+			if released > 0 {
+				t.Error("Call running on a released handle")
+			}
+			inUse--
+			if r.end() {
+				released++
+			}
+			mu.Unlock()
+		}
+
+		for i := 0; i < 32; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 20; j++ {
+					call()
+				}
+			}()
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mu.Lock()
+			if r.close() {
+				if inUse != 0 {
+					t.Error("Close released the handle with calls in flight")
+				}
+				released++
+			}
+			mu.Unlock()
+		}()
+
+		wg.Wait()
+		if released != 1 {
+			t.Fatalf("Handle released %d times, want 1", released)
+		}
+	}
+}
+
+func TestClientClosedRejectsCalls(t *testing.T) {
+	c := &Client{}
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close returned %v", err)
+	}
+
+	if _, err := c.DataGetPublic(context.Background(), "00"); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed, got %v", err)
+	}
+	if _, err := c.DataCost(context.Background(), []byte("data")); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed, got %v", err)
+	}
+	if c.CloneHandle() != nil {
+		t.Fatal("CloneHandle returned a handle after Close")
+	}
+}
+
+func TestClientConcurrentCloseRace(t *testing.T) {
+	c := &Client{}
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := c.DataGetPublic(context.Background(), "00")
+			if !errors.Is(err, ErrDisposed) {
+				t.Errorf("Expected ErrDisposed, got %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			_ = c.Close()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestWalletClosedRejectsCalls(t *testing.T) {
+	w := &Wallet{}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close returned %v", err)
+	}
+	if _, err := w.BalanceOfTokens(context.Background()); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed, got %v", err)
+	}
+}
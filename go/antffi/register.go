@@ -25,10 +25,17 @@ extern void* uniffi_ant_ffi_fn_clone_registeraddress(void* ptr, RustCallStatus*
 // Register Functions
 extern void* uniffi_ant_ffi_fn_func_register_key_from_name(void* owner, RustBuffer name, RustCallStatus* status);
 extern RustBuffer uniffi_ant_ffi_fn_func_register_value_from_bytes(RustBuffer bytes, RustCallStatus* status);
+
+// RegisterHistory
+extern uint64_t uniffi_ant_ffi_fn_method_registerhistory_next(void* ptr);
+extern void uniffi_ant_ffi_fn_free_registerhistory(void* ptr, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_clone_registerhistory(void* ptr, RustCallStatus* status);
 */
 import "C"
 
 import (
+	"context"
+	"encoding/binary"
 	"runtime"
 	"sync"
 	"unsafe"
@@ -53,9 +60,10 @@ func NewRegisterAddress(owner *PublicKey) (*RegisterAddress, error) {
 	}
 
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_constructor_registeraddress_new(cloned, &status)
 
-	if err := checkStatus(&status, "RegisterAddress.New"); err != nil {
+	if err := checkStatus(&status, "RegisterAddress.New", liftRegisterError); err != nil {
 		return nil, err
 	}
 
@@ -69,7 +77,7 @@ func RegisterAddressFromHex(hex string) (*RegisterAddress, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_registeraddress_from_hex(hexBuffer, &status)
 
-	if err := checkStatus(&status, "RegisterAddress.FromHex"); err != nil {
+	if err := checkStatus(&status, "RegisterAddress.FromHex", liftRegisterError); err != nil {
 		return nil, err
 	}
 
@@ -79,6 +87,7 @@ func RegisterAddressFromHex(hex string) (*RegisterAddress, error) {
 func newRegisterAddress(handle unsafe.Pointer) *RegisterAddress {
 	ra := &RegisterAddress{handle: handle}
 	runtime.SetFinalizer(ra, (*RegisterAddress).Free)
+	trackHandle(unsafe.Pointer(ra), "RegisterAddress", ra.handle)
 	return ra
 }
 
@@ -92,9 +101,16 @@ func (ra *RegisterAddress) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_registeraddress(ra.handle, &status)
+	untrackHandle(unsafe.Pointer(ra))
 	ra.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (ra *RegisterAddress) Close() error {
+	ra.Free()
+	return nil
+}
+
 func (ra *RegisterAddress) Owner() (*PublicKey, error) {
 	ra.mu.Lock()
 	defer ra.mu.Unlock()
@@ -105,9 +121,10 @@ func (ra *RegisterAddress) Owner() (*PublicKey, error) {
 
 	cloned := ra.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_method_registeraddress_owner(cloned, &status)
 
-	if err := checkStatus(&status, "RegisterAddress.Owner"); err != nil {
+	if err := checkStatus(&status, "RegisterAddress.Owner", liftRegisterError); err != nil {
 		return nil, err
 	}
 
@@ -124,9 +141,10 @@ func (ra *RegisterAddress) ToHex() (string, error) {
 
 	cloned := ra.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_registeraddress_to_hex(cloned, &status)
 
-	if err := checkStatus(&status, "RegisterAddress.ToHex"); err != nil {
+	if err := checkStatus(&status, "RegisterAddress.ToHex", liftRegisterError); err != nil {
 		return "", err
 	}
 
@@ -135,7 +153,7 @@ func (ra *RegisterAddress) ToHex() (string, error) {
 
 func (ra *RegisterAddress) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_registeraddress(ra.handle, &status)
+	return trackClone("RegisterAddress", C.uniffi_ant_ffi_fn_clone_registeraddress(ra.handle, &status))
 }
 
 func (ra *RegisterAddress) CloneHandle() unsafe.Pointer {
@@ -160,9 +178,10 @@ func RegisterKeyFromName(owner *SecretKey, name string) (*SecretKey, error) {
 
 	nameBuffer := stringToRustBuffer(name)
 	var status C.RustCallStatus
+	consumeClones(clonedOwner)
 	handle := C.uniffi_ant_ffi_fn_func_register_key_from_name(clonedOwner, nameBuffer, &status)
 
-	if err := checkStatus(&status, "RegisterKeyFromName"); err != nil {
+	if err := checkStatus(&status, "RegisterKeyFromName", liftRegisterError); err != nil {
 		return nil, err
 	}
 
@@ -175,9 +194,158 @@ func RegisterValueFromBytes(data []byte) ([]byte, error) {
 	var status C.RustCallStatus
 	result := C.uniffi_ant_ffi_fn_func_register_value_from_bytes(dataBuffer, &status)
 
-	if err := checkStatus(&status, "RegisterValueFromBytes"); err != nil {
+	if err := checkStatus(&status, "RegisterValueFromBytes", liftRegisterError); err != nil {
 		return nil, err
 	}
 
 	return fromRustBuffer(result, true), nil
 }
+
+// RegisterHistory iterates over the values a register has held, oldest first.
+// Each call to Next fetches one value from the network.
+// Values use the same 32-byte encoding as RegisterValueFromBytes.
+// A RegisterHistory is safe for concurrent use.
+type RegisterHistory struct {
+	handle unsafe.Pointer
+	client *Client
+	calls  callRefs
+	done   bool
+	mu     sync.Mutex
+
+	// fetch returns the next value, or nil once the history is exhausted.
+	fetch func(ctx context.Context) ([]byte, error)
+}
+
+func newRegisterHistory(client *Client, handle unsafe.Pointer) *RegisterHistory {
+	h := &RegisterHistory{handle: handle, client: client}
+	h.fetch = h.fetchNext
+	runtime.SetFinalizer(h, (*RegisterHistory).Free)
+	trackHandle(unsafe.Pointer(h), "RegisterHistory", h.handle)
+	return h
+}
+
+// Free releases the iterator. Calls made after Free fail with ErrDisposed;
+// a Next already in flight keeps the native handle alive until it returns.
+func (h *RegisterHistory) Free() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.calls.close() && h.handle != nil {
+		h.releaseHandle()
+	}
+}
+
+// Close implements io.Closer by calling Free. It always returns nil.
+func (h *RegisterHistory) Close() error {
+	h.Free()
+	return nil
+}
+
+// releaseHandle frees the native handle. h.mu must be held.
+func (h *RegisterHistory) releaseHandle() {
+	var status C.RustCallStatus
+	C.uniffi_ant_ffi_fn_free_registerhistory(h.handle, &status)
+	untrackHandle(unsafe.Pointer(h))
+	h.handle = nil
+}
+
+// Next returns the next value in the register history.
+// Returns nil when the history is exhausted.
+func (h *RegisterHistory) Next(ctx context.Context) ([]byte, error) {
+	h.mu.Lock()
+	if h.calls.closed {
+		h.mu.Unlock()
+		return nil, ErrDisposed
+	}
+	if h.done {
+		h.mu.Unlock()
+		return nil, nil
+	}
+	h.mu.Unlock()
+
+	value, err := h.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		h.mu.Lock()
+		h.done = true
+		h.mu.Unlock()
+	}
+	return value, nil
+}
+
+// All returns every value not yet returned by Next.
+// The iterator is exhausted afterwards.
+func (h *RegisterHistory) All(ctx context.Context) ([][]byte, error) {
+	var values [][]byte
+	for {
+		value, err := h.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			return values, nil
+		}
+		values = append(values, value)
+	}
+}
+
+// fetchNext fetches one value through the native iterator.
+func (h *RegisterHistory) fetchNext(ctx context.Context) ([]byte, error) {
+	h.mu.Lock()
+	if h.handle == nil {
+		h.mu.Unlock()
+		return nil, ErrDisposed
+	}
+	if err := h.calls.begin(); err != nil {
+		h.mu.Unlock()
+		return nil, err
+	}
+	var status C.RustCallStatus
+	cloned := C.uniffi_ant_ffi_fn_clone_registerhistory(h.handle, &status)
+	h.mu.Unlock()
+
+	defer func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if h.calls.end() {
+			h.releaseHandle()
+		}
+	}()
+	if err := checkStatus(&status, "clone registerhistory", nil); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := h.client.withTimeout(ctx, opRead, "RegisterHistory.Next")
+	defer cancel()
+
+	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_registerhistory_next(cloned))
+	buf, err := pollRustBufferFuture(ctx, futureHandle)
+	if err != nil {
+		return nil, err
+	}
+	return decodeRegisterHistoryValue(fromRustBufferRaw(buf, true))
+}
+
+// decodeRegisterHistoryValue decodes the Option<Vec<u8>> returned by the
+// native iterator. None decodes as a nil value.
+func decodeRegisterHistoryValue(data []byte) ([]byte, error) {
+	if len(data) < 1 {
+		return nil, malformedRecord("Option<Vec<u8>>", len(data))
+	}
+	switch data[0] {
+	case 0:
+		if len(data) != 1 {
+			return nil, malformedRecord("Option<Vec<u8>>", len(data))
+		}
+		return nil, nil
+	case 1:
+		if len(data) < 5 || len(data)-5 != int(binary.BigEndian.Uint32(data[1:])) {
+			return nil, malformedRecord("Option<Vec<u8>>", len(data))
+		}
+		return append([]byte{}, data[5:]...), nil
+	default:
+		return nil, malformedRecord("Option<Vec<u8>>", len(data))
+	}
+}
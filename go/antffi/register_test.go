@@ -0,0 +1,188 @@
+package antffi
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+// fakeRegisterHistory returns a RegisterHistory that yields values in order
+// without a native iterator, and a pointer to the number of fetches made.
+func fakeRegisterHistory(values ...[]byte) (*RegisterHistory, *int) {
+	fetches := 0
+	h := &RegisterHistory{}
+	h.fetch = func(context.Context) ([]byte, error) {
+		fetches++
+		if fetches > len(values) {
+			return nil, nil
+		}
+		return values[fetches-1], nil
+	}
+	return h, &fetches
+}
+
+// encodeOptionBytes hand-builds the UniFFI encoding of Option<Vec<u8>>.
+func encodeOptionBytes(value []byte) []byte {
+	if value == nil {
+		return []byte{0}
+	}
+	buf := make([]byte, 5+len(value))
+	buf[0] = 1
+	binary.BigEndian.PutUint32(buf[1:5], uint32(len(value)))
+	copy(buf[5:], value)
+	return buf
+}
+
+func TestRegisterHistoryNextOrder(t *testing.T) {
+	ctx := context.Background()
+	values := [][]byte{{1}, {2}, {3}}
+	h, fetches := fakeRegisterHistory(values...)
+
+	for i, want := range values {
+		got, err := h.Next(ctx)
+		if err != nil {
+			t.Fatalf("Next %d failed: %v", i, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Fatalf("Next %d returned %v, want %v", i, got, want)
+		}
+	}
+
+	// Exhaustion is remembered, so later calls do not fetch again.
+	for i := 0; i < 2; i++ {
+		got, err := h.Next(ctx)
+		if err != nil || got != nil {
+			t.Fatalf("Expected nil after the last value, got %v, %v", got, err)
+		}
+	}
+	if *fetches != len(values)+1 {
+		t.Fatalf("Expected %d fetches, got %d", len(values)+1, *fetches)
+	}
+}
+
+func TestRegisterHistoryAllAfterNext(t *testing.T) {
+	ctx := context.Background()
+	h, _ := fakeRegisterHistory([]byte{1}, []byte{2}, []byte{3})
+
+	first, err := h.Next(ctx)
+	if err != nil || !bytes.Equal(first, []byte{1}) {
+		t.Fatalf("Next returned %v, %v", first, err)
+	}
+
+	rest, err := h.All(ctx)
+	if err != nil {
+		t.Fatalf("All failed: %v", err)
+	}
+	if len(rest) != 2 || !bytes.Equal(rest[0], []byte{2}) || !bytes.Equal(rest[1], []byte{3}) {
+		t.Fatalf("All returned %v", rest)
+	}
+
+	rest, err = h.All(ctx)
+	if err != nil || len(rest) != 0 {
+		t.Fatalf("Expected an exhausted iterator, got %v, %v", rest, err)
+	}
+	if value, err := h.Next(ctx); err != nil || value != nil {
+		t.Fatalf("Expected nil from Next, got %v, %v", value, err)
+	}
+}
+
+func TestRegisterHistoryEmpty(t *testing.T) {
+	h, _ := fakeRegisterHistory()
+
+	values, err := h.All(context.Background())
+	if err != nil || len(values) != 0 {
+		t.Fatalf("Expected no values, got %v, %v", values, err)
+	}
+}
+
+func TestRegisterHistoryError(t *testing.T) {
+	ctx := context.Background()
+	fetchErr := errors.New("network down")
+	calls := 0
+	h := &RegisterHistory{}
+	h.fetch = func(context.Context) ([]byte, error) {
+		calls++
+		if calls == 2 {
+			return nil, fetchErr
+		}
+		return []byte{byte(calls)}, nil
+	}
+
+	if _, err := h.All(ctx); !errors.Is(err, fetchErr) {
+		t.Fatalf("Expected the fetch error, got %v", err)
+	}
+
+	// A failed fetch does not exhaust the iterator.
+	value, err := h.Next(ctx)
+	if err != nil || !bytes.Equal(value, []byte{3}) {
+		t.Fatalf("Next after an error returned %v, %v", value, err)
+	}
+}
+
+func TestRegisterHistoryFreed(t *testing.T) {
+	h, fetches := fakeRegisterHistory([]byte{1})
+	h.Free()
+
+	if _, err := h.Next(context.Background()); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Next: expected ErrDisposed, got %v", err)
+	}
+	if _, err := h.All(context.Background()); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("All: expected ErrDisposed, got %v", err)
+	}
+	if *fetches != 0 {
+		t.Fatalf("Expected no fetches, got %d", *fetches)
+	}
+}
+
+func TestClientRegisterHistoryArguments(t *testing.T) {
+	ctx := context.Background()
+	c := &Client{}
+
+	if _, err := c.RegisterHistory(ctx, nil); !errors.Is(err, ErrNilPointer) {
+		t.Fatalf("Expected ErrNilPointer, got %v", err)
+	}
+	if _, err := c.RegisterHistory(ctx, &RegisterAddress{}); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed, got %v", err)
+	}
+}
+
+func TestDecodeRegisterHistoryValue(t *testing.T) {
+	value := bytes.Repeat([]byte{0xab}, 32)
+
+	got, err := decodeRegisterHistoryValue(encodeOptionBytes(value))
+	if err != nil || !bytes.Equal(got, value) {
+		t.Fatalf("Decoded %x, %v", got, err)
+	}
+
+	got, err = decodeRegisterHistoryValue(encodeOptionBytes(nil))
+	if err != nil || got != nil {
+		t.Fatalf("Expected nil for None, got %x, %v", got, err)
+	}
+
+	// An empty value is still a value, not the end of the history.
+	got, err = decodeRegisterHistoryValue(encodeOptionBytes([]byte{}))
+	if err != nil || got == nil || len(got) != 0 {
+		t.Fatalf("Expected an empty value, got %v, %v", got, err)
+	}
+}
+
+func TestDecodeRegisterHistoryValueMalformed(t *testing.T) {
+	valid := encodeOptionBytes([]byte{1, 2, 3})
+	cases := map[string][]byte{
+		"empty":          nil,
+		"bad flag":       {2},
+		"none with data": {0, 0},
+		"short length":   valid[:3],
+		"truncated":      valid[:len(valid)-1],
+		"trailing bytes": append(append([]byte{}, valid...), 0),
+	}
+	for name, data := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := decodeRegisterHistoryValue(data); !errors.Is(err, ErrMalformedResult) {
+				t.Fatalf("Expected ErrMalformedResult, got %v", err)
+			}
+		})
+	}
+}
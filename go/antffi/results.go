@@ -0,0 +1,102 @@
+package antffi
+
+// Decoders for the UniFFI result records returned by paid Client writes.
+// Each record is a cost string followed by one object handle.
+
+func decodeDataPutResult(data []byte) (*DataPutResult, error) {
+	cost, dataMap, err := decodeCostAndHandle(data, "DataPutResult", newDataMapChunk)
+	if err != nil {
+		return nil, err
+	}
+	return &DataPutResult{Cost: cost, DataMap: dataMap}, nil
+}
+
+func decodeFileUploadResult(data []byte) (*FileUploadResult, error) {
+	cost, dataMap, err := decodeCostAndHandle(data, "FileUploadResult", newDataMapChunk)
+	if err != nil {
+		return nil, err
+	}
+	return &FileUploadResult{Cost: cost, DataMap: dataMap}, nil
+}
+
+func decodeFileUploadPublicResult(data []byte) (*FileUploadPublicResult, error) {
+	cost, address, err := decodeCostAndHandle(data, "FileUploadPublicResult", newDataAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &FileUploadPublicResult{Cost: cost, Address: address}, nil
+}
+
+func decodeChunkPutResult(data []byte) (*ChunkPutResult, error) {
+	cost, address, err := decodeCostAndHandle(data, "ChunkPutResult", newChunkAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &ChunkPutResult{Cost: cost, Address: address}, nil
+}
+
+func decodeGraphEntryPutResult(data []byte) (*GraphEntryPutResult, error) {
+	cost, address, err := decodeCostAndHandle(data, "GraphEntryPutResult", newGraphEntryAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &GraphEntryPutResult{Cost: cost, Address: address}, nil
+}
+
+func decodeRegisterCreateResult(data []byte) (*RegisterCreateResult, error) {
+	cost, address, err := decodeCostAndHandle(data, "RegisterCreateResult", newRegisterAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &RegisterCreateResult{Cost: cost, Address: address}, nil
+}
+
+func decodePointerCreateResult(data []byte) (*PointerCreateResult, error) {
+	cost, address, err := decodeCostAndHandle(data, "PointerCreateResult", newPointerAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &PointerCreateResult{Cost: cost, Address: address}, nil
+}
+
+// decodeScratchpadCreateResult decodes the record returned by both
+// scratchpad_create and scratchpad_put.
+func decodeScratchpadCreateResult(data []byte) (*ScratchpadCreateResult, error) {
+	cost, address, err := decodeCostAndHandle(data, "ScratchpadCreateResult", newScratchpadAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &ScratchpadCreateResult{Cost: cost, Address: address}, nil
+}
+
+func decodePublicArchivePutResult(data []byte) (*PublicArchivePutResult, error) {
+	cost, address, err := decodeCostAndHandle(data, "PublicArchivePutResult", newArchiveAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &PublicArchivePutResult{Cost: cost, Address: address}, nil
+}
+
+func decodeDirUploadResult(data []byte) (*DirUploadResult, error) {
+	cost, dataMap, err := decodeCostAndHandle(data, "DirUploadResult", newPrivateArchiveDataMap)
+	if err != nil {
+		return nil, err
+	}
+	return &DirUploadResult{Cost: cost, DataMap: dataMap}, nil
+}
+
+func decodeDirContentUploadResult(data []byte) (*DirContentUploadResult, error) {
+	cost, archive, err := decodeCostAndHandle(data, "DirContentUploadResult", newPrivateArchive)
+	if err != nil {
+		return nil, err
+	}
+	return &DirContentUploadResult{Cost: cost, Archive: archive}, nil
+}
+
+func decodeDirUploadPublicResult(data []byte) (*DirUploadPublicResult, error) {
+	cost, address, err := decodeCostAndHandle(data, "DirUploadPublicResult", newArchiveAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &DirUploadPublicResult{Cost: cost, Address: address}, nil
+}
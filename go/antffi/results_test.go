@@ -0,0 +1,246 @@
+package antffi
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+	"unsafe"
+)
+
+// buildCostAndHandle hand-builds a UniFFI record of a cost string followed by an object handle.
+func buildCostAndHandle(cost string, handle uint64) []byte {
+	buf := make([]byte, 4+len(cost)+8)
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(cost)))
+	copy(buf[4:], cost)
+	binary.BigEndian.PutUint64(buf[4+len(cost):], handle)
+	return buf
+}
+
+// fakeObject stands in for a handle wrapper, recording whether it was freed.
+type fakeObject struct {
+	handle unsafe.Pointer
+	freed  bool
+}
+
+func (o *fakeObject) Free() { o.freed = true }
+
+func TestDecodeCostAndHandle(t *testing.T) {
+	var lifted *fakeObject
+	lift := func(handle unsafe.Pointer) *fakeObject {
+		lifted = &fakeObject{handle: handle}
+		return lifted
+	}
+
+	cost, object, err := decodeCostAndHandle(buildCostAndHandle("1500", 0x1000), "ChunkPutResult", lift)
+	if err != nil {
+		t.Fatalf("decodeCostAndHandle failed: %v", err)
+	}
+	if cost != "1500" || uintptr(object.handle) != 0x1000 || object.freed {
+		t.Fatalf("Decoded cost %q and handle %p (freed %v)", cost, object.handle, object.freed)
+	}
+
+	// An empty cost is still a well-formed record.
+	if cost, _, err := decodeCostAndHandle(buildCostAndHandle("", 0x2000), "ChunkPutResult", lift); err != nil || cost != "" {
+		t.Fatalf("Empty cost decoded as %q, %v", cost, err)
+	}
+}
+
+func TestDecodeCostAndHandleMalformed(t *testing.T) {
+	valid := buildCostAndHandle("1500", 0x1000)
+
+	cases := map[string][]byte{
+		"empty":            nil,
+		"truncated cost":   valid[:6],
+		"truncated handle": valid[:len(valid)-1],
+		"overlong cost":    append([]byte{0, 0, 1, 0}, valid[4:]...),
+	}
+
+	for name, data := range cases {
+		t.Run(name, func(t *testing.T) {
+			lift := func(unsafe.Pointer) *fakeObject {
+				t.Fatal("Lifted a handle from a malformed record")
+				return nil
+			}
+			_, _, err := decodeCostAndHandle(data, "ChunkPutResult", lift)
+			if !errors.Is(err, ErrMalformedResult) {
+				t.Fatalf("Expected ErrMalformedResult, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDecodeCostAndHandleTrailingBytesFreesHandle(t *testing.T) {
+	data := append(buildCostAndHandle("1500", 0x1000), 0)
+
+	var lifted *fakeObject
+	_, object, err := decodeCostAndHandle(data, "ChunkPutResult", func(handle unsafe.Pointer) *fakeObject {
+		lifted = &fakeObject{handle: handle}
+		return lifted
+	})
+	if !errors.Is(err, ErrMalformedResult) {
+		t.Fatalf("Expected ErrMalformedResult, got %v", err)
+	}
+	if object != nil {
+		t.Fatal("Expected no object with the error")
+	}
+	if lifted == nil || !lifted.freed || uintptr(lifted.handle) != 0x1000 {
+		t.Fatalf("Expected the handle to be freed, got %+v", lifted)
+	}
+}
+
+// The per-record tests decode hand-built records carrying fake handles. Each
+// wrapper is marked freed afterwards so its finalizer never passes the fake
+// handle to Rust.
+
+func TestDecodeChunkPutResult(t *testing.T) {
+	r, err := decodeChunkPutResult(buildCostAndHandle("1500", 0x1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Address.freed = true
+	if r.Cost != "1500" || uintptr(r.Address.handle) != 0x1000 {
+		t.Fatalf("Decoded %q, %p", r.Cost, r.Address.handle)
+	}
+}
+
+func TestDecodePointerCreateResult(t *testing.T) {
+	r, err := decodePointerCreateResult(buildCostAndHandle("0", 0x2000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Address.freed = true
+	if r.Cost != "0" || uintptr(r.Address.handle) != 0x2000 {
+		t.Fatalf("Decoded %q, %p", r.Cost, r.Address.handle)
+	}
+}
+
+func TestDecodeScratchpadCreateResult(t *testing.T) {
+	r, err := decodeScratchpadCreateResult(buildCostAndHandle("42000000000000", 0x3000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Address.freed = true
+	if r.Cost != "42000000000000" || uintptr(r.Address.handle) != 0x3000 {
+		t.Fatalf("Decoded %q, %p", r.Cost, r.Address.handle)
+	}
+}
+
+func TestDecodeRegisterCreateResult(t *testing.T) {
+	r, err := decodeRegisterCreateResult(buildCostAndHandle("7", 0x4000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Address.freed = true
+	if r.Cost != "7" || uintptr(r.Address.handle) != 0x4000 {
+		t.Fatalf("Decoded %q, %p", r.Cost, r.Address.handle)
+	}
+}
+
+func TestDecodeGraphEntryPutResult(t *testing.T) {
+	r, err := decodeGraphEntryPutResult(buildCostAndHandle("123456789012345678901234567890", 0x5000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Address.freed = true
+	if r.Cost != "123456789012345678901234567890" || uintptr(r.Address.handle) != 0x5000 {
+		t.Fatalf("Decoded %q, %p", r.Cost, r.Address.handle)
+	}
+}
+
+func TestDecodeFileUploadResult(t *testing.T) {
+	r, err := decodeFileUploadResult(buildCostAndHandle("99", 0x6000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.DataMap.freed = true
+	if r.Cost != "99" || uintptr(r.DataMap.handle) != 0x6000 {
+		t.Fatalf("Decoded %q, %p", r.Cost, r.DataMap.handle)
+	}
+}
+
+func TestDecodeFileUploadPublicResult(t *testing.T) {
+	r, err := decodeFileUploadPublicResult(buildCostAndHandle("", 0x7000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Address.freed = true
+	if r.Cost != "" || uintptr(r.Address.handle) != 0x7000 {
+		t.Fatalf("Decoded %q, %p", r.Cost, r.Address.handle)
+	}
+}
+
+func TestDecodeDataPutResult(t *testing.T) {
+	r, err := decodeDataPutResult(buildCostAndHandle("12", 0x8000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.DataMap.freed = true
+	if r.Cost != "12" || uintptr(r.DataMap.handle) != 0x8000 {
+		t.Fatalf("Decoded %q, %p", r.Cost, r.DataMap.handle)
+	}
+}
+
+func TestDecodePublicArchivePutResult(t *testing.T) {
+	r, err := decodePublicArchivePutResult(buildCostAndHandle("3", 0x9000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Address.freed = true
+	if r.Cost != "3" || uintptr(r.Address.handle) != 0x9000 {
+		t.Fatalf("Decoded %q, %p", r.Cost, r.Address.handle)
+	}
+}
+
+func TestDecodeDirUploadResult(t *testing.T) {
+	r, err := decodeDirUploadResult(buildCostAndHandle("250", 0xa000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.DataMap.freed = true
+	if r.Cost != "250" || uintptr(r.DataMap.handle) != 0xa000 {
+		t.Fatalf("Decoded %q, %p", r.Cost, r.DataMap.handle)
+	}
+}
+
+func TestDecodeDirUploadPublicResult(t *testing.T) {
+	r, err := decodeDirUploadPublicResult(buildCostAndHandle("251", 0xb000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Address.freed = true
+	if r.Cost != "251" || uintptr(r.Address.handle) != 0xb000 {
+		t.Fatalf("Decoded %q, %p", r.Cost, r.Address.handle)
+	}
+}
+
+func TestDecodeDirContentUploadResult(t *testing.T) {
+	r, err := decodeDirContentUploadResult(buildCostAndHandle("252", 0xc000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Archive.freed = true
+	if r.Cost != "252" || uintptr(r.Archive.handle) != 0xc000 {
+		t.Fatalf("Decoded %q, %p", r.Cost, r.Archive.handle)
+	}
+}
+
+func TestDecodeResultMalformed(t *testing.T) {
+	truncated := buildCostAndHandle("1500", 0x1000)[:10]
+	decoders := map[string]func([]byte) error{
+		"ChunkPutResult":         func(b []byte) error { _, err := decodeChunkPutResult(b); return err },
+		"PointerCreateResult":    func(b []byte) error { _, err := decodePointerCreateResult(b); return err },
+		"ScratchpadCreateResult": func(b []byte) error { _, err := decodeScratchpadCreateResult(b); return err },
+		"RegisterCreateResult":   func(b []byte) error { _, err := decodeRegisterCreateResult(b); return err },
+		"GraphEntryPutResult":    func(b []byte) error { _, err := decodeGraphEntryPutResult(b); return err },
+		"FileUploadResult":       func(b []byte) error { _, err := decodeFileUploadResult(b); return err },
+		"FileUploadPublicResult": func(b []byte) error { _, err := decodeFileUploadPublicResult(b); return err },
+		"PublicArchivePutResult": func(b []byte) error { _, err := decodePublicArchivePutResult(b); return err },
+		"DirUploadResult":        func(b []byte) error { _, err := decodeDirUploadResult(b); return err },
+		"DirUploadPublicResult":  func(b []byte) error { _, err := decodeDirUploadPublicResult(b); return err },
+	}
+	for record, decode := range decoders {
+		if err := decode(truncated); !errors.Is(err, ErrMalformedResult) {
+			t.Errorf("%s: expected ErrMalformedResult, got %v", record, err)
+		}
+	}
+}
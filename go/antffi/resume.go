@@ -0,0 +1,295 @@
+package antffi
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// resumeBlockSize is how much DownloadResumable fetches, writes and records
+// per step.
+const resumeBlockSize = dataReaderBlockSize
+
+// resumeStateVersion is bumped when the state file format changes; state
+// files from another version are ignored.
+const resumeStateVersion = 2
+
+const (
+	partialSuffix = ".partial"
+	stateSuffix   = ".partial.state"
+)
+
+// resumeState is the sidecar file of a resumable download. The file is a
+// JSON line with the fields below, followed by one JSON line per block of the
+// .partial file that was written and synced, in order. Blocks are appended as
+// they are written, so recording one does not rewrite the others.
+type resumeState struct {
+	Version   int    `json:"version"`
+	Source    string `json:"source"`
+	Size      uint64 `json:"size"`
+	BlockSize uint64 `json:"block_size"`
+
+	Ranges []resumeRange `json:"-"`
+}
+
+// resumeRange is a block of the .partial file.
+type resumeRange struct {
+	Offset uint64 `json:"offset"`
+	Length uint64 `json:"length"`
+	SHA256 string `json:"sha256"`
+}
+
+// DownloadResumable downloads private data to destPath, continuing an earlier
+// attempt that failed, was cancelled or crashed.
+//
+// The data is written to destPath+".partial". After each block is synced to
+// disk, its range and SHA-256 hash are appended to destPath+".partial.state". A later
+// call checks the recorded blocks against the partial file and continues after
+// the last one that matches. Once all the data is written, the partial file is
+// renamed to destPath and the state file is removed.
+//
+// Each block is fetched under the client's retry policy.
+func (c *Client) DownloadResumable(ctx context.Context, dataMap *DataMapChunk, destPath string) error {
+	if dataMap == nil {
+		return ErrNilPointer
+	}
+
+	ctx, cancel := c.withTimeout(ctx, opTransfer, "DownloadResumable")
+	defer cancel()
+
+	address, err := dataMap.Address()
+	if err != nil {
+		return err
+	}
+	stream, err := retryCall(ctx, c, func(ctx context.Context) (*DataStream, error) {
+		return c.DataStream(ctx, dataMap)
+	})
+	if err != nil {
+		return err
+	}
+	defer stream.Free()
+
+	return downloadResumable(ctx, stream, "private:"+address, destPath, resumeBlockSize, c.retryPolicy(ctx))
+}
+
+// DownloadResumablePublic is DownloadResumable for public data.
+func (c *Client) DownloadResumablePublic(ctx context.Context, address *DataAddress, destPath string) error {
+	if address == nil {
+		return ErrNilPointer
+	}
+
+	ctx, cancel := c.withTimeout(ctx, opTransfer, "DownloadResumablePublic")
+	defer cancel()
+
+	addressHex, err := address.ToHex()
+	if err != nil {
+		return err
+	}
+	stream, err := retryCall(ctx, c, func(ctx context.Context) (*DataStream, error) {
+		return c.DataStreamPublic(ctx, address)
+	})
+	if err != nil {
+		return err
+	}
+	defer stream.Free()
+
+	return downloadResumable(ctx, stream, "public:"+addressHex, destPath, resumeBlockSize, c.retryPolicy(ctx))
+}
+
+// downloadResumable copies src to destPath through a .partial file and its
+// state file. source identifies the data, so a partial download of something
+// else is started over rather than continued.
+func downloadResumable(ctx context.Context, src dataSource, source, destPath string, blockSize uint64, policy RetryPolicy) error {
+	size, err := src.DataSize()
+	if err != nil {
+		return err
+	}
+	partialPath, statePath := destPath+partialSuffix, destPath+stateSuffix
+
+	state, offset := resumeFrom(partialPath, statePath, source, size, blockSize)
+
+	file, err := os.OpenFile(partialPath, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// Drop anything past the verified blocks, such as a block written just
+	// before a crash but never recorded.
+	if err := file.Truncate(int64(offset)); err != nil {
+		return err
+	}
+	if err := saveResumeState(statePath, state); err != nil {
+		return err
+	}
+	stateFile, err := os.OpenFile(statePath, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return err
+	}
+	defer stateFile.Close()
+
+	for offset < size {
+		if err := ctx.Err(); err != nil {
+			return contextError(ctx)
+		}
+
+		length := size - offset
+		if length > state.BlockSize {
+			length = state.BlockSize
+		}
+		block, err := runWithRetry(ctx, policy, func(context.Context) ([]byte, error) {
+			data, err := src.GetRange(offset, length)
+			if err == nil && uint64(len(data)) < length {
+				err = fmt.Errorf("range at %d: %w", offset, io.ErrUnexpectedEOF)
+			}
+			return data, err
+		})
+		if err != nil {
+			return err
+		}
+		block = block[:length]
+
+		if _, err := file.WriteAt(block, int64(offset)); err != nil {
+			return err
+		}
+		if err := file.Sync(); err != nil {
+			return err
+		}
+
+		sum := sha256.Sum256(block)
+		if err := appendResumeRange(stateFile, resumeRange{Offset: offset, Length: length, SHA256: hex.EncodeToString(sum[:])}); err != nil {
+			return err
+		}
+		offset += length
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+	stateFile.Close()
+	if err := os.Rename(partialPath, destPath); err != nil {
+		return err
+	}
+	// The download is complete even if this fails: a state file without its
+	// partial file is ignored by the next download.
+	_ = os.Remove(statePath)
+	return nil
+}
+
+// resumeFrom loads the state left by an earlier attempt, trimmed to the
+// blocks that still match the partial file, and returns it with the offset to
+// continue from. A missing, unreadable or mismatched state starts over. The
+// caller saves the trimmed state, so later blocks are appended after it.
+func resumeFrom(partialPath, statePath, source string, size, blockSize uint64) (*resumeState, uint64) {
+	fresh := &resumeState{Version: resumeStateVersion, Source: source, Size: size, BlockSize: blockSize}
+
+	state, err := loadResumeState(statePath)
+	if err != nil || state.Version != resumeStateVersion || state.Source != source || state.Size != size || state.BlockSize == 0 {
+		return fresh, 0
+	}
+	file, err := os.Open(partialPath)
+	if err != nil {
+		return fresh, 0
+	}
+	defer file.Close()
+
+	var offset uint64
+	verified := state.Ranges[:0]
+	for _, r := range state.Ranges {
+		if r.Offset != offset || r.Length == 0 || !rangeMatches(file, r) {
+			break
+		}
+		verified = append(verified, r)
+		offset += r.Length
+	}
+	state.Ranges = verified
+	return state, offset
+}
+
+// rangeMatches reports whether the bytes of r in file hash to r.SHA256.
+func rangeMatches(file *os.File, r resumeRange) bool {
+	h := sha256.New()
+	n, err := io.Copy(h, io.NewSectionReader(file, int64(r.Offset), int64(r.Length)))
+	return err == nil && uint64(n) == r.Length && hex.EncodeToString(h.Sum(nil)) == r.SHA256
+}
+
+// loadResumeState reads a state file. Block records end at the first line
+// that does not decode, such as one torn by a crash while it was appended.
+func loadResumeState(path string) (*resumeState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	lines := bytes.Split(data, []byte("\n"))
+
+	var state resumeState
+	if err := json.Unmarshal(lines[0], &state); err != nil {
+		return nil, err
+	}
+	for _, line := range lines[1:] {
+		var r resumeRange
+		if err := json.Unmarshal(line, &r); err != nil {
+			break
+		}
+		state.Ranges = append(state.Ranges, r)
+	}
+	return &state, nil
+}
+
+// appendResumeRange records a block at the end of the open state file.
+func appendResumeRange(file *os.File, r resumeRange) error {
+	line, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("saving download state: %w", err)
+	}
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("saving download state: %w", err)
+	}
+	return nil
+}
+
+// saveResumeState replaces the state file atomically, so a crash leaves
+// either the old state or the new one.
+func saveResumeState(path string, state *resumeState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	for _, r := range state.Ranges {
+		line, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		data = append(append(data, line...), '\n')
+	}
+
+	tmpPath := path + ".tmp"
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(data)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		return errors.Join(fmt.Errorf("saving download state: %w", err), os.Remove(tmpPath))
+	}
+	return nil
+}
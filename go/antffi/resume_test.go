@@ -0,0 +1,282 @@
+package antffi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+// flakyStream is a fakeStream whose next failures GetRange calls at or past
+// failAt return err. It records the offsets it was asked for.
+type flakyStream struct {
+	*fakeStream
+	failAt   uint64
+	failures int
+	err      error
+	onRange  func(start uint64)
+
+	mu      sync.Mutex
+	offsets []uint64
+}
+
+func (f *flakyStream) GetRange(start, length uint64) ([]byte, error) {
+	f.mu.Lock()
+	f.offsets = append(f.offsets, start)
+	fail := start >= f.failAt && f.failures > 0
+	if fail {
+		f.failures--
+	}
+	f.mu.Unlock()
+
+	if f.onRange != nil {
+		f.onRange(start)
+	}
+	if fail {
+		return nil, f.err
+	}
+	return f.fakeStream.GetRange(start, length)
+}
+
+func (f *flakyStream) requested() []uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]uint64(nil), f.offsets...)
+}
+
+var errBrokenStream = errors.New("stream broke")
+
+const testResumeBlock = 1000
+
+func resumePaths(t *testing.T) (dest, partial, state string) {
+	dest = filepath.Join(t.TempDir(), "out.bin")
+	return dest, dest + partialSuffix, dest + stateSuffix
+}
+
+func checkDownloaded(t *testing.T, dest string, want []byte) {
+	t.Helper()
+	got, err := os.ReadFile(dest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("Downloaded %d bytes that differ from the %d bytes of source data", len(got), len(want))
+	}
+	for _, path := range []string{dest + partialSuffix, dest + stateSuffix} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Fatalf("Expected %s to be removed, got %v", filepath.Base(path), err)
+		}
+	}
+}
+
+// interrupt runs a download that fails at offset failAt and checks what it leaves behind.
+func interrupt(t *testing.T, f *fakeStream, dest string, failAt uint64) {
+	t.Helper()
+	flaky := &flakyStream{fakeStream: f, failAt: failAt, failures: 1, err: errBrokenStream}
+	err := downloadResumable(context.Background(), flaky, "src", dest, testResumeBlock, RetryPolicy{})
+	if !errors.Is(err, errBrokenStream) {
+		t.Fatalf("Expected the stream error, got %v", err)
+	}
+	if _, err := os.Stat(dest); !os.IsNotExist(err) {
+		t.Fatalf("Expected no destination file after a failure, got %v", err)
+	}
+	info, err := os.Stat(dest + partialSuffix)
+	if err != nil || uint64(info.Size()) != failAt {
+		t.Fatalf("Expected a %d byte partial file, got %v, %v", failAt, info, err)
+	}
+}
+
+// resume completes a download and returns the offsets it fetched.
+func resume(t *testing.T, f *fakeStream, source, dest string) []uint64 {
+	t.Helper()
+	flaky := &flakyStream{fakeStream: f}
+	if err := downloadResumable(context.Background(), flaky, source, dest, testResumeBlock, RetryPolicy{}); err != nil {
+		t.Fatalf("downloadResumable failed: %v", err)
+	}
+	checkDownloaded(t, dest, f.data)
+	return flaky.requested()
+}
+
+func TestDownloadResumable(t *testing.T) {
+	f := newFakeStream(10_500, 0)
+	dest, _, _ := resumePaths(t)
+
+	offsets := resume(t, f, "src", dest)
+	if len(offsets) != 11 || offsets[10] != 10_000 {
+		t.Fatalf("Fetched offsets %v, want 11 blocks", offsets)
+	}
+}
+
+func TestDownloadResumableResumes(t *testing.T) {
+	f := newFakeStream(10_500, 0)
+	dest, _, statePath := resumePaths(t)
+
+	interrupt(t, f, dest, 4000)
+	state, err := loadResumeState(statePath)
+	if err != nil || len(state.Ranges) != 4 || state.Size != 10_500 {
+		t.Fatalf("Unexpected state %+v, %v", state, err)
+	}
+
+	offsets := resume(t, f, "src", dest)
+	if len(offsets) != 7 || offsets[0] != 4000 {
+		t.Fatalf("Resume fetched offsets %v, want 7 blocks from 4000", offsets)
+	}
+}
+
+func TestDownloadResumableRetries(t *testing.T) {
+	f := newFakeStream(5000, 0)
+	dest, _, _ := resumePaths(t)
+
+	flaky := &flakyStream{
+		fakeStream: f,
+		failAt:     2000,
+		failures:   2,
+		err:        &ClientError{Kind: ClientNetworkError, Reason: "unreachable"},
+	}
+	policy := RetryPolicy{MaxAttempts: 3}
+	if err := downloadResumable(context.Background(), flaky, "src", dest, testResumeBlock, policy); err != nil {
+		t.Fatalf("downloadResumable failed: %v", err)
+	}
+	checkDownloaded(t, dest, f.data)
+	if offsets := flaky.requested(); len(offsets) != 7 {
+		t.Fatalf("Fetched offsets %v, want 5 blocks and 2 retries", offsets)
+	}
+}
+
+func TestDownloadResumableCorruptPartial(t *testing.T) {
+	f := newFakeStream(6000, 0)
+	dest, partialPath, _ := resumePaths(t)
+	interrupt(t, f, dest, 4000)
+
+	file, err := os.OpenFile(partialPath, os.O_RDWR, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.WriteAt([]byte{^f.data[2500]}, 2500); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+
+	offsets := resume(t, f, "src", dest)
+	if len(offsets) != 4 || offsets[0] != 2000 {
+		t.Fatalf("Resume fetched offsets %v, want 4 blocks from 2000", offsets)
+	}
+}
+
+func TestDownloadResumableUnrecordedWrite(t *testing.T) {
+	f := newFakeStream(6000, 0)
+	dest, partialPath, _ := resumePaths(t)
+	interrupt(t, f, dest, 3000)
+
+	// A crash after writing a block but before recording it leaves extra bytes.
+	file, err := os.OpenFile(partialPath, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.Write(make([]byte, 700)); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+
+	offsets := resume(t, f, "src", dest)
+	if len(offsets) != 3 || offsets[0] != 3000 {
+		t.Fatalf("Resume fetched offsets %v, want 3 blocks from 3000", offsets)
+	}
+}
+
+func TestDownloadResumableTornRecord(t *testing.T) {
+	f := newFakeStream(6000, 0)
+	dest, _, statePath := resumePaths(t)
+	interrupt(t, f, dest, 3000)
+
+	// A crash while appending a record leaves a partial last line.
+	file, err := os.OpenFile(statePath, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.WriteString(`{"offset":3000,"len`); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+
+	state, err := loadResumeState(statePath)
+	if err != nil || len(state.Ranges) != 3 {
+		t.Fatalf("Unexpected state %+v, %v", state, err)
+	}
+
+	offsets := resume(t, f, "src", dest)
+	if len(offsets) != 3 || offsets[0] != 3000 {
+		t.Fatalf("Resume fetched offsets %v, want 3 blocks from 3000", offsets)
+	}
+}
+
+func TestDownloadResumableStartsOver(t *testing.T) {
+	cases := map[string]func(partialPath, statePath string) (*fakeStream, string){
+		"other source": func(_, _ string) (*fakeStream, string) {
+			return newFakeStream(5000, 0), "other"
+		},
+		"other size": func(_, _ string) (*fakeStream, string) {
+			return newFakeStream(5500, 0), "src"
+		},
+		"missing partial": func(partialPath, _ string) (*fakeStream, string) {
+			os.Remove(partialPath)
+			return newFakeStream(5000, 0), "src"
+		},
+		"unreadable state": func(_, statePath string) (*fakeStream, string) {
+			os.WriteFile(statePath, []byte("{"), 0o644)
+			return newFakeStream(5000, 0), "src"
+		},
+	}
+	for name, setup := range cases {
+		t.Run(name, func(t *testing.T) {
+			dest, partialPath, statePath := resumePaths(t)
+			interrupt(t, newFakeStream(5000, 0), dest, 3000)
+
+			f, source := setup(partialPath, statePath)
+			if offsets := resume(t, f, source, dest); offsets[0] != 0 {
+				t.Fatalf("Resume fetched offsets %v, want a restart from 0", offsets)
+			}
+		})
+	}
+}
+
+func TestDownloadResumableCancel(t *testing.T) {
+	f := newFakeStream(6000, 0)
+	dest, partialPath, _ := resumePaths(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	flaky := &flakyStream{fakeStream: f, onRange: func(start uint64) {
+		if start == 2000 {
+			cancel()
+		}
+	}}
+	err := downloadResumable(ctx, flaky, "src", dest, testResumeBlock, RetryPolicy{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if info, err := os.Stat(partialPath); err != nil || info.Size() != 3000 {
+		t.Fatalf("Expected a 3000 byte partial file, got %v, %v", info, err)
+	}
+
+	offsets := resume(t, f, "src", dest)
+	if len(offsets) != 3 || offsets[0] != 3000 {
+		t.Fatalf("Resume fetched offsets %v, want 3 blocks from 3000", offsets)
+	}
+}
+
+func TestDownloadResumableArguments(t *testing.T) {
+	c := &Client{}
+	dest := filepath.Join(t.TempDir(), "out.bin")
+	if err := c.DownloadResumable(context.Background(), nil, dest); !errors.Is(err, ErrNilPointer) {
+		t.Fatalf("Expected ErrNilPointer, got %v", err)
+	}
+	if err := c.DownloadResumablePublic(context.Background(), nil, dest); !errors.Is(err, ErrNilPointer) {
+		t.Fatalf("Expected ErrNilPointer, got %v", err)
+	}
+	if err := c.DownloadResumablePublic(context.Background(), &DataAddress{freed: true}, dest); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed, got %v", err)
+	}
+}
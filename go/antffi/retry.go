@@ -0,0 +1,173 @@
+package antffi
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy controls how Client retries operations that fail with a transient error.
+//
+// Only idempotent reads (gets, cost quotes, existence checks, downloads) and
+// unpaid writes (pointer and scratchpad updates) are retried; paid uploads are
+// always attempted once so a retry can never pay twice.
+//
+// The zero value performs a single attempt.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	// Values below 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the second attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts. Zero means no cap.
+	MaxBackoff time.Duration
+	// Multiplier scales the delay after each attempt. Values below 1 are treated as 2.
+	Multiplier float64
+	// Jitter randomizes each delay by up to this fraction in either direction (0 to 1).
+	Jitter float64
+	// AttemptTimeout bounds each individual attempt. Zero means only ctx applies.
+	AttemptTimeout time.Duration
+	// Retryable decides whether an error is worth retrying. Nil means IsTransient.
+	Retryable func(error) bool
+}
+
+// DefaultRetryPolicy returns a policy suitable for reads over a flaky network:
+// four attempts with exponential backoff from 250ms up to 5s and 20% jitter.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 250 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// IsTransient reports whether err is a failure that may succeed on retry.
+// Network failures reported by the Rust client are transient; invalid
+// arguments, decoding failures, cancellations and Rust panics are permanent.
+func IsTransient(err error) bool {
+	if err == nil || errors.Is(err, ErrRustPanic) || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var clientErr *ClientError
+	if errors.As(err, &clientErr) {
+		return clientErr.Kind == ClientNetworkError
+	}
+	return false
+}
+
+// backoff returns the delay before the attempt following the given one (1-based).
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	delay := float64(p.InitialBackoff)
+	for i := 1; i < attempt; i++ {
+		delay *= multiplier
+		if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		jitter := p.Jitter
+		if jitter > 1 {
+			jitter = 1
+		}
+		delay *= 1 - jitter + 2*jitter*rand.Float64()
+	}
+	return time.Duration(delay)
+}
+
+type retryPolicyKey struct{}
+
+// WithRetryPolicy returns a context that overrides the client's retry policy
+// for calls made with it.
+func WithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// SetRetryPolicy sets the retry policy used by this client's retryable operations.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.retry = policy
+}
+
+// RetryPolicy returns the client's retry policy.
+func (c *Client) RetryPolicy() RetryPolicy {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.retry
+}
+
+// retryPolicy resolves the policy for a call: the ctx override if present,
+// otherwise the client's policy.
+func (c *Client) retryPolicy(ctx context.Context) RetryPolicy {
+	if policy, ok := ctx.Value(retryPolicyKey{}).(RetryPolicy); ok {
+		return policy
+	}
+	return c.RetryPolicy()
+}
+
+// retryCall runs a retryable client operation under the resolved retry policy.
+func retryCall[T any](ctx context.Context, c *Client, attempt func(context.Context) (T, error)) (T, error) {
+	return runWithRetry(ctx, c.retryPolicy(ctx), attempt)
+}
+
+// retryVoidCall is retryCall for operations that only return an error.
+func retryVoidCall(ctx context.Context, c *Client, attempt func(context.Context) error) error {
+	_, err := retryCall(ctx, c, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, attempt(ctx)
+	})
+	return err
+}
+
+// runWithRetry calls attempt until it succeeds, fails permanently, the policy's
+// attempts are exhausted or ctx is done. The last attempt's error is returned.
+func runWithRetry[T any](ctx context.Context, policy RetryPolicy, attempt func(context.Context) (T, error)) (T, error) {
+	retryable := policy.Retryable
+	if retryable == nil {
+		retryable = IsTransient
+	}
+
+	for n := 1; ; n++ {
+		attemptCtx, cancel := ctx, context.CancelFunc(func() {})
+		if policy.AttemptTimeout > 0 {
+			attemptCtx, cancel = context.WithTimeout(ctx, policy.AttemptTimeout)
+		}
+		result, err := attempt(attemptCtx)
+		cancel()
+
+		if err == nil || n >= policy.MaxAttempts || ctx.Err() != nil {
+			return result, err
+		}
+
+		// A per-attempt timeout with the caller's context still live is transient.
+		attemptTimedOut := policy.AttemptTimeout > 0 && errors.Is(err, context.DeadlineExceeded)
+		if !attemptTimedOut && !retryable(err) {
+			return result, err
+		}
+
+		timer := time.NewTimer(policy.backoff(n))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			// Report a default timeout as such; otherwise the last attempt's
+			// error says more than ctx.Err().
+			if ctxErr := contextError(ctx); errors.Is(ctxErr, ErrTimeout) {
+				return result, ctxErr
+			}
+			return result, err
+		case <-timer.C:
+		}
+	}
+}
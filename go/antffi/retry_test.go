@@ -0,0 +1,212 @@
+package antffi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fastRetryPolicy retries quickly so tests do not sleep for long.
+func fastRetryPolicy(attempts int) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    attempts,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		Multiplier:     2,
+	}
+}
+
+func TestRunWithRetryTransient(t *testing.T) {
+	calls := 0
+	result, err := runWithRetry(context.Background(), fastRetryPolicy(4), func(ctx context.Context) (string, error) {
+		calls++
+		if calls < 3 {
+			return "", &ClientError{Kind: ClientNetworkError, Reason: "timeout"}
+		}
+		return "ok", nil
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	if result != "ok" || calls != 3 {
+		t.Fatalf("Expected ok after 3 calls, got %q after %d", result, calls)
+	}
+}
+
+func TestRunWithRetryPermanent(t *testing.T) {
+	permanent := []error{
+		&ClientError{Kind: ClientInvalidAddress, Reason: "bad hex"},
+		&PanicError{Operation: "op", Message: "boom"},
+		ErrDisposed,
+		context.Canceled,
+	}
+
+	for _, want := range permanent {
+		calls := 0
+		_, err := runWithRetry(context.Background(), fastRetryPolicy(4), func(ctx context.Context) (int, error) {
+			calls++
+			return 0, want
+		})
+		if !errors.Is(err, want) {
+			t.Fatalf("Expected %v, got %v", want, err)
+		}
+		if calls != 1 {
+			t.Fatalf("Permanent error %v retried %d times", want, calls)
+		}
+	}
+}
+
+func TestRunWithRetryExhausted(t *testing.T) {
+	calls := 0
+	_, err := runWithRetry(context.Background(), fastRetryPolicy(3), func(ctx context.Context) (int, error) {
+		calls++
+		return 0, &ClientError{Kind: ClientNetworkError, Reason: "attempt"}
+	})
+	if !errors.Is(err, ErrNetwork) {
+		t.Fatalf("Expected last network error, got %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("Expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestRunWithRetryZeroPolicy(t *testing.T) {
+	calls := 0
+	_, err := runWithRetry(context.Background(), RetryPolicy{}, func(ctx context.Context) (int, error) {
+		calls++
+		return 0, &ClientError{Kind: ClientNetworkError}
+	})
+	if err == nil || calls != 1 {
+		t.Fatalf("Zero policy should make a single attempt, got %d calls", calls)
+	}
+}
+
+func TestRunWithRetryAttemptTimeout(t *testing.T) {
+	policy := fastRetryPolicy(3)
+	policy.AttemptTimeout = 10 * time.Millisecond
+
+	calls := 0
+	_, err := runWithRetry(context.Background(), policy, func(ctx context.Context) (int, error) {
+		calls++
+		if calls == 1 {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}
+		if _, ok := ctx.Deadline(); !ok {
+			t.Fatal("Attempt context has no deadline")
+		}
+		return 1, nil
+	})
+	if err != nil {
+		t.Fatalf("Expected success after attempt timeout, got %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", calls)
+	}
+}
+
+func TestRunWithRetryContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	policy := fastRetryPolicy(10)
+	policy.InitialBackoff = time.Hour
+	policy.MaxBackoff = time.Hour
+
+	calls := 0
+	done := make(chan error, 1)
+	go func() {
+		_, err := runWithRetry(ctx, policy, func(ctx context.Context) (int, error) {
+			calls++
+			return 0, &ClientError{Kind: ClientNetworkError}
+		})
+		done <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, ErrNetwork) {
+			t.Fatalf("Expected last attempt error, got %v", err)
+		}
+		if calls != 1 {
+			t.Fatalf("Expected 1 attempt before cancellation, got %d", calls)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("runWithRetry did not stop on cancellation")
+	}
+}
+
+func TestRunWithRetryCustomClassifier(t *testing.T) {
+	policy := fastRetryPolicy(3)
+	policy.Retryable = func(err error) bool { return errors.Is(err, ErrKey) }
+
+	calls := 0
+	_, _ = runWithRetry(context.Background(), policy, func(ctx context.Context) (int, error) {
+		calls++
+		return 0, &KeyError{Kind: KeyInvalidKey}
+	})
+	if calls != 3 {
+		t.Fatalf("Expected custom classifier to retry, got %d calls", calls)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+	}
+
+	want := []time.Duration{100, 200, 400, 800, 1000, 1000}
+	for i, w := range want {
+		if got := policy.backoff(i + 1); got != w*time.Millisecond {
+			t.Fatalf("backoff(%d) = %v, want %v", i+1, got, w*time.Millisecond)
+		}
+	}
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		got := policy.backoff(2)
+		if got < 100*time.Millisecond || got > 300*time.Millisecond {
+			t.Fatalf("Jittered backoff %v outside [100ms, 300ms]", got)
+		}
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{&ClientError{Kind: ClientNetworkError}, true},
+		{&ClientError{Kind: ClientInitializationFailed}, false},
+		{&ClientError{Kind: ClientInvalidAddress}, false},
+		{&PanicError{Operation: "op"}, false},
+		{&KeyError{Kind: KeyInvalidKey}, false},
+		{ErrMalformedResult, false},
+		{context.Canceled, false},
+	}
+
+	for _, tc := range cases {
+		if got := IsTransient(tc.err); got != tc.want {
+			t.Fatalf("IsTransient(%v) = %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
+
+func TestClientRetryPolicyOverride(t *testing.T) {
+	c := &Client{}
+	c.SetRetryPolicy(DefaultRetryPolicy())
+
+	if got := c.retryPolicy(context.Background()); got.MaxAttempts != 4 {
+		t.Fatalf("Expected client policy, got %+v", got)
+	}
+
+	ctx := WithRetryPolicy(context.Background(), RetryPolicy{MaxAttempts: 1})
+	if got := c.retryPolicy(ctx); got.MaxAttempts != 1 {
+		t.Fatalf("Expected per-call override, got %+v", got)
+	}
+}
@@ -30,12 +30,19 @@ extern uint64_t uniffi_ant_ffi_fn_method_scratchpad_counter(void* ptr, RustCallS
 extern RustBuffer uniffi_ant_ffi_fn_method_scratchpad_decrypt_data(void* ptr, void* secretKey, RustCallStatus* status);
 extern void* uniffi_ant_ffi_fn_method_scratchpad_owner(void* ptr, RustCallStatus* status);
 extern RustBuffer uniffi_ant_ffi_fn_method_scratchpad_encrypted_data(void* ptr, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_constructor_scratchpad_from_bytes(RustBuffer bytes, RustCallStatus* status);
+extern RustBuffer uniffi_ant_ffi_fn_method_scratchpad_to_bytes(void* ptr, RustCallStatus* status);
+extern RustBuffer uniffi_ant_ffi_fn_method_scratchpad_snapshot(void* ptr, RustCallStatus* status);
 extern void uniffi_ant_ffi_fn_free_scratchpad(void* ptr, RustCallStatus* status);
 extern void* uniffi_ant_ffi_fn_clone_scratchpad(void* ptr, RustCallStatus* status);
+
+// Scratchpad Functions
+extern void uniffi_ant_ffi_fn_func_scratchpad_verify(void* scratchpad, RustCallStatus* status);
 */
 import "C"
 
 import (
+	"errors"
 	"runtime"
 	"sync"
 	"unsafe"
@@ -60,9 +67,10 @@ func NewScratchpadAddress(pk *PublicKey) (*ScratchpadAddress, error) {
 	}
 
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_constructor_scratchpadaddress_new(cloned, &status)
 
-	if err := checkStatus(&status, "ScratchpadAddress.New"); err != nil {
+	if err := checkStatus(&status, "ScratchpadAddress.New", liftScratchpadError); err != nil {
 		return nil, err
 	}
 
@@ -76,7 +84,7 @@ func ScratchpadAddressFromHex(hex string) (*ScratchpadAddress, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_scratchpadaddress_from_hex(hexBuffer, &status)
 
-	if err := checkStatus(&status, "ScratchpadAddress.FromHex"); err != nil {
+	if err := checkStatus(&status, "ScratchpadAddress.FromHex", liftScratchpadError); err != nil {
 		return nil, err
 	}
 
@@ -86,6 +94,7 @@ func ScratchpadAddressFromHex(hex string) (*ScratchpadAddress, error) {
 func newScratchpadAddress(handle unsafe.Pointer) *ScratchpadAddress {
 	sa := &ScratchpadAddress{handle: handle}
 	runtime.SetFinalizer(sa, (*ScratchpadAddress).Free)
+	trackHandle(unsafe.Pointer(sa), "ScratchpadAddress", sa.handle)
 	return sa
 }
 
@@ -99,9 +108,16 @@ func (sa *ScratchpadAddress) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_scratchpadaddress(sa.handle, &status)
+	untrackHandle(unsafe.Pointer(sa))
 	sa.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (sa *ScratchpadAddress) Close() error {
+	sa.Free()
+	return nil
+}
+
 func (sa *ScratchpadAddress) Owner() (*PublicKey, error) {
 	sa.mu.Lock()
 	defer sa.mu.Unlock()
@@ -112,9 +128,10 @@ func (sa *ScratchpadAddress) Owner() (*PublicKey, error) {
 
 	cloned := sa.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_method_scratchpadaddress_owner(cloned, &status)
 
-	if err := checkStatus(&status, "ScratchpadAddress.Owner"); err != nil {
+	if err := checkStatus(&status, "ScratchpadAddress.Owner", liftScratchpadError); err != nil {
 		return nil, err
 	}
 
@@ -131,9 +148,10 @@ func (sa *ScratchpadAddress) ToHex() (string, error) {
 
 	cloned := sa.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_scratchpadaddress_to_hex(cloned, &status)
 
-	if err := checkStatus(&status, "ScratchpadAddress.ToHex"); err != nil {
+	if err := checkStatus(&status, "ScratchpadAddress.ToHex", liftScratchpadError); err != nil {
 		return "", err
 	}
 
@@ -142,7 +160,7 @@ func (sa *ScratchpadAddress) ToHex() (string, error) {
 
 func (sa *ScratchpadAddress) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_scratchpadaddress(sa.handle, &status)
+	return trackClone("ScratchpadAddress", C.uniffi_ant_ffi_fn_clone_scratchpadaddress(sa.handle, &status))
 }
 
 func (sa *ScratchpadAddress) CloneHandle() unsafe.Pointer {
@@ -154,6 +172,38 @@ func (sa *ScratchpadAddress) CloneHandle() unsafe.Pointer {
 	return sa.cloneHandle()
 }
 
+// ScratchpadSnapshot holds every public field of a Scratchpad as plain Go values.
+type ScratchpadSnapshot struct {
+	// Address is the hex-encoded address where the scratchpad is stored.
+	Address string
+	// Owner is the hex-encoded public key of the owner.
+	Owner string
+	// DataEncoding is the application-defined content type.
+	DataEncoding uint64
+	// Counter is the scratchpad's version.
+	Counter uint64
+	// EncryptedData is the payload as stored; decrypt it with DecryptData.
+	EncryptedData []byte
+}
+
+// decodeScratchpadSnapshot decodes a UniFFI-serialized ScratchpadSnapshot record.
+func decodeScratchpadSnapshot(data []byte) (ScratchpadSnapshot, error) {
+	reader := NewUniFFIReader(data)
+	snapshot := ScratchpadSnapshot{
+		Address:       reader.ReadString(),
+		Owner:         reader.ReadString(),
+		DataEncoding:  reader.ReadUint64(),
+		Counter:       reader.ReadUint64(),
+		EncryptedData: reader.ReadBytes(),
+	}
+
+	if reader.remaining() != 0 {
+		return ScratchpadSnapshot{}, malformedRecord("ScratchpadSnapshot", len(data))
+	}
+
+	return snapshot, nil
+}
+
 // Scratchpad represents an encrypted mutable data store.
 type Scratchpad struct {
 	handle unsafe.Pointer
@@ -174,10 +224,25 @@ func NewScratchpad(owner *SecretKey, dataEncoding uint64, data []byte, counter u
 
 	dataBuffer := toRustBuffer(data)
 	var status C.RustCallStatus
+	consumeClones(clonedOwner)
 	handle := C.uniffi_ant_ffi_fn_constructor_scratchpad_new(
 		clonedOwner, C.uint64_t(dataEncoding), dataBuffer, C.uint64_t(counter), &status)
 
-	if err := checkStatus(&status, "Scratchpad.New"); err != nil {
+	if err := checkStatus(&status, "Scratchpad.New", liftScratchpadError); err != nil {
+		return nil, err
+	}
+
+	return newScratchpad(handle), nil
+}
+
+// ScratchpadFromBytes decodes a scratchpad produced by Scratchpad.ToBytes.
+// The signature is not checked; call Verify before trusting the scratchpad.
+func ScratchpadFromBytes(data []byte) (*Scratchpad, error) {
+	dataBuffer := toRustBuffer(data)
+	var status C.RustCallStatus
+	handle := C.uniffi_ant_ffi_fn_constructor_scratchpad_from_bytes(dataBuffer, &status)
+
+	if err := checkStatus(&status, "Scratchpad.FromBytes", liftScratchpadError); err != nil {
 		return nil, err
 	}
 
@@ -187,6 +252,7 @@ func NewScratchpad(owner *SecretKey, dataEncoding uint64, data []byte, counter u
 func newScratchpad(handle unsafe.Pointer) *Scratchpad {
 	s := &Scratchpad{handle: handle}
 	runtime.SetFinalizer(s, (*Scratchpad).Free)
+	trackHandle(unsafe.Pointer(s), "Scratchpad", s.handle)
 	return s
 }
 
@@ -200,9 +266,16 @@ func (s *Scratchpad) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_scratchpad(s.handle, &status)
+	untrackHandle(unsafe.Pointer(s))
 	s.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (s *Scratchpad) Close() error {
+	s.Free()
+	return nil
+}
+
 func (s *Scratchpad) Address() (*ScratchpadAddress, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -213,9 +286,10 @@ func (s *Scratchpad) Address() (*ScratchpadAddress, error) {
 
 	cloned := s.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_method_scratchpad_address(cloned, &status)
 
-	if err := checkStatus(&status, "Scratchpad.Address"); err != nil {
+	if err := checkStatus(&status, "Scratchpad.Address", liftScratchpadError); err != nil {
 		return nil, err
 	}
 
@@ -232,9 +306,10 @@ func (s *Scratchpad) DataEncoding() (uint64, error) {
 
 	cloned := s.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_scratchpad_data_encoding(cloned, &status)
 
-	if err := checkStatus(&status, "Scratchpad.DataEncoding"); err != nil {
+	if err := checkStatus(&status, "Scratchpad.DataEncoding", liftScratchpadError); err != nil {
 		return 0, err
 	}
 
@@ -251,9 +326,10 @@ func (s *Scratchpad) Counter() (uint64, error) {
 
 	cloned := s.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_scratchpad_counter(cloned, &status)
 
-	if err := checkStatus(&status, "Scratchpad.Counter"); err != nil {
+	if err := checkStatus(&status, "Scratchpad.Counter", liftScratchpadError); err != nil {
 		return 0, err
 	}
 
@@ -271,16 +347,17 @@ func (s *Scratchpad) DecryptData(sk *SecretKey) ([]byte, error) {
 		return nil, ErrInvalidArgument
 	}
 
-	cloned := s.cloneHandle()
 	clonedSk := sk.CloneHandle()
 	if clonedSk == nil {
 		return nil, ErrDisposed
 	}
+	cloned := s.cloneHandle()
 
 	var status C.RustCallStatus
+	consumeClones(cloned, clonedSk)
 	result := C.uniffi_ant_ffi_fn_method_scratchpad_decrypt_data(cloned, clonedSk, &status)
 
-	if err := checkStatus(&status, "Scratchpad.DecryptData"); err != nil {
+	if err := checkStatus(&status, "Scratchpad.DecryptData", liftScratchpadError); err != nil {
 		return nil, err
 	}
 
@@ -297,9 +374,10 @@ func (s *Scratchpad) Owner() (*PublicKey, error) {
 
 	cloned := s.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	handle := C.uniffi_ant_ffi_fn_method_scratchpad_owner(cloned, &status)
 
-	if err := checkStatus(&status, "Scratchpad.Owner"); err != nil {
+	if err := checkStatus(&status, "Scratchpad.Owner", liftScratchpadError); err != nil {
 		return nil, err
 	}
 
@@ -316,18 +394,89 @@ func (s *Scratchpad) EncryptedData() ([]byte, error) {
 
 	cloned := s.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_scratchpad_encrypted_data(cloned, &status)
 
-	if err := checkStatus(&status, "Scratchpad.EncryptedData"); err != nil {
+	if err := checkStatus(&status, "Scratchpad.EncryptedData", liftScratchpadError); err != nil {
 		return nil, err
 	}
 
 	return fromRustBuffer(result, true), nil
 }
 
+// Snapshot returns every public field of the scratchpad in a single FFI call,
+// without allocating a handle per field. Prefer it to the individual getters
+// when reading many scratchpads.
+func (s *Scratchpad) Snapshot() (ScratchpadSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.freed {
+		return ScratchpadSnapshot{}, ErrDisposed
+	}
+
+	cloned := s.cloneHandle()
+	var status C.RustCallStatus
+	consumeClones(cloned)
+	result := C.uniffi_ant_ffi_fn_method_scratchpad_snapshot(cloned, &status)
+
+	if err := checkStatus(&status, "Scratchpad.Snapshot", liftScratchpadError); err != nil {
+		return ScratchpadSnapshot{}, err
+	}
+
+	return decodeScratchpadSnapshot(fromRustBufferRaw(result, true))
+}
+
+// ToBytes encodes the scratchpad, including its signature, for caching or relaying.
+func (s *Scratchpad) ToBytes() ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.freed {
+		return nil, ErrDisposed
+	}
+
+	cloned := s.cloneHandle()
+	var status C.RustCallStatus
+	consumeClones(cloned)
+	result := C.uniffi_ant_ffi_fn_method_scratchpad_to_bytes(cloned, &status)
+
+	if err := checkStatus(&status, "Scratchpad.ToBytes", liftScratchpadError); err != nil {
+		return nil, err
+	}
+
+	return fromRustBuffer(result, true), nil
+}
+
+// Verify checks that the scratchpad is signed by its owner.
+// Returns a *VerificationError if the signature is invalid.
+func (s *Scratchpad) Verify() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.freed {
+		return ErrDisposed
+	}
+
+	cloned := s.cloneHandle()
+	var status C.RustCallStatus
+	consumeClones(cloned)
+	C.uniffi_ant_ffi_fn_func_scratchpad_verify(cloned, &status)
+
+	if err := checkStatus(&status, "Scratchpad.Verify", liftClientError); err != nil {
+		var clientErr *ClientError
+		if errors.As(err, &clientErr) {
+			return &VerificationError{Kind: "scratchpad", Reason: clientErr.Reason}
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (s *Scratchpad) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_scratchpad(s.handle, &status)
+	return trackClone("Scratchpad", C.uniffi_ant_ffi_fn_clone_scratchpad(s.handle, &status))
 }
 
 func (s *Scratchpad) CloneHandle() unsafe.Pointer {
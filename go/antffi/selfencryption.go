@@ -57,8 +57,8 @@ func Encrypt(data []byte) (*EncryptedData, error) {
 
 	resultBuffer := C.uniffi_ant_ffi_fn_func_encrypt(inputBuffer, &status)
 
-	if err := checkStatus(&status, "encrypt"); err != nil {
-		return nil, &EncryptionError{Wrapped: err}
+	if err := checkStatus(&status, "encrypt", liftEncryptionError); err != nil {
+		return nil, err
 	}
 
 	// Get raw bytes without UniFFI deserialization (this is the serialized EncryptedData record)
@@ -95,13 +95,13 @@ func DecryptBytes(encryptedBytes []byte) ([]byte, error) {
 
 	var status C.RustCallStatus
 	inputBuffer := C.ffi_ant_ffi_rustbuffer_from_bytes(fb, &status)
-	if err := checkStatus(&status, "create input buffer"); err != nil {
-		return nil, &DecryptionError{Wrapped: err}
+	if err := checkStatus(&status, "create input buffer", nil); err != nil {
+		return nil, err
 	}
 
 	resultBuffer := C.uniffi_ant_ffi_fn_func_decrypt(inputBuffer, &status)
-	if err := checkStatus(&status, "decrypt"); err != nil {
-		return nil, &DecryptionError{Wrapped: err}
+	if err := checkStatus(&status, "decrypt", liftDecryptionError); err != nil {
+		return nil, err
 	}
 
 	return fromRustBuffer(resultBuffer, true), nil
@@ -0,0 +1,190 @@
+package antffi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// recordWriter hand-builds UniFFI-serialized records.
+type recordWriter struct {
+	bytes.Buffer
+}
+
+func (w *recordWriter) int32(v int32) *recordWriter {
+	_ = binary.Write(&w.Buffer, binary.BigEndian, v)
+	return w
+}
+
+func (w *recordWriter) uint64(v uint64) *recordWriter {
+	_ = binary.Write(&w.Buffer, binary.BigEndian, v)
+	return w
+}
+
+func (w *recordWriter) bytes(b []byte) *recordWriter {
+	w.int32(int32(len(b)))
+	w.Write(b)
+	return w
+}
+
+func (w *recordWriter) string(s string) *recordWriter {
+	return w.bytes([]byte(s))
+}
+
+func buildPointerSnapshot(s PointerSnapshot) []byte {
+	var w recordWriter
+	w.string(s.Address).string(s.Owner).uint64(s.Counter).int32(int32(s.TargetKind)).string(s.Target)
+	return w.Bytes()
+}
+
+func buildScratchpadSnapshot(s ScratchpadSnapshot) []byte {
+	var w recordWriter
+	w.string(s.Address).string(s.Owner).uint64(s.DataEncoding).uint64(s.Counter).bytes(s.EncryptedData)
+	return w.Bytes()
+}
+
+func buildGraphEntrySnapshot(s GraphEntrySnapshot) []byte {
+	var w recordWriter
+	w.string(s.Address).string(s.Owner).bytes(s.Content[:])
+	w.int32(int32(len(s.Parents)))
+	for _, parent := range s.Parents {
+		w.string(parent)
+	}
+	w.int32(int32(len(s.Descendants)))
+	for _, descendant := range s.Descendants {
+		w.string(descendant.PublicKey).bytes(descendant.Content[:])
+	}
+	return w.Bytes()
+}
+
+var (
+	testPointerSnapshot = PointerSnapshot{
+		Address:    strings.Repeat("a1", 48),
+		Owner:      strings.Repeat("b2", 48),
+		Counter:    42,
+		TargetKind: PointerTargetScratchpad,
+		Target:     strings.Repeat("c3", 48),
+	}
+
+	testScratchpadSnapshot = ScratchpadSnapshot{
+		Address:       strings.Repeat("a1", 48),
+		Owner:         strings.Repeat("b2", 48),
+		DataEncoding:  7,
+		Counter:       3,
+		EncryptedData: []byte("ciphertext"),
+	}
+
+	testGraphEntrySnapshot = GraphEntrySnapshot{
+		Address:     strings.Repeat("a1", 48),
+		Owner:       strings.Repeat("b2", 48),
+		Content:     [GraphContentSize]byte{1, 2, 3},
+		Parents:     []string{strings.Repeat("c3", 48), strings.Repeat("d4", 48)},
+		Descendants: []GraphDescendantSnapshot{{PublicKey: strings.Repeat("e5", 48), Content: [GraphContentSize]byte{9}}},
+	}
+)
+
+func TestDecodePointerSnapshot(t *testing.T) {
+	got, err := decodePointerSnapshot(buildPointerSnapshot(testPointerSnapshot))
+	if err != nil {
+		t.Fatalf("decodePointerSnapshot failed: %v", err)
+	}
+	if got != testPointerSnapshot {
+		t.Fatalf("Snapshot mismatch: %+v != %+v", got, testPointerSnapshot)
+	}
+}
+
+func TestDecodeScratchpadSnapshot(t *testing.T) {
+	got, err := decodeScratchpadSnapshot(buildScratchpadSnapshot(testScratchpadSnapshot))
+	if err != nil {
+		t.Fatalf("decodeScratchpadSnapshot failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, testScratchpadSnapshot) {
+		t.Fatalf("Snapshot mismatch: %+v != %+v", got, testScratchpadSnapshot)
+	}
+}
+
+func TestDecodeGraphEntrySnapshot(t *testing.T) {
+	got, err := decodeGraphEntrySnapshot(buildGraphEntrySnapshot(testGraphEntrySnapshot))
+	if err != nil {
+		t.Fatalf("decodeGraphEntrySnapshot failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, testGraphEntrySnapshot) {
+		t.Fatalf("Snapshot mismatch: %+v != %+v", got, testGraphEntrySnapshot)
+	}
+
+	empty := GraphEntrySnapshot{Address: "00", Owner: "11", Parents: []string{}, Descendants: []GraphDescendantSnapshot{}}
+	got, err = decodeGraphEntrySnapshot(buildGraphEntrySnapshot(empty))
+	if err != nil {
+		t.Fatalf("decodeGraphEntrySnapshot failed for an entry without links: %v", err)
+	}
+	if !reflect.DeepEqual(got, empty) {
+		t.Fatalf("Snapshot mismatch: %+v != %+v", got, empty)
+	}
+}
+
+func TestDecodeSnapshotMalformed(t *testing.T) {
+	pointer := buildPointerSnapshot(testPointerSnapshot)
+	badKind := testPointerSnapshot
+	badKind.TargetKind = 9
+	scratchpad := buildScratchpadSnapshot(testScratchpadSnapshot)
+	graphEntry := buildGraphEntrySnapshot(testGraphEntrySnapshot)
+
+	var hugeCount recordWriter
+	hugeCount.string("00").string("11").bytes(make([]byte, GraphContentSize)).int32(1 << 30)
+
+	var shortGraphContent recordWriter
+	shortGraphContent.string("00").string("11").bytes([]byte{1}).int32(0).int32(0)
+
+	cases := map[string]func() error{
+		"pointer empty":          func() error { _, err := decodePointerSnapshot(nil); return err },
+		"pointer truncated":      func() error { _, err := decodePointerSnapshot(pointer[:len(pointer)-1]); return err },
+		"pointer trailing":       func() error { _, err := decodePointerSnapshot(append(pointer, 0)); return err },
+		"pointer unknown kind":   func() error { _, err := decodePointerSnapshot(buildPointerSnapshot(badKind)); return err },
+		"scratchpad truncated":   func() error { _, err := decodeScratchpadSnapshot(scratchpad[:len(scratchpad)-1]); return err },
+		"scratchpad trailing":    func() error { _, err := decodeScratchpadSnapshot(append(scratchpad, 0)); return err },
+		"graph entry truncated":  func() error { _, err := decodeGraphEntrySnapshot(graphEntry[:len(graphEntry)-1]); return err },
+		"graph entry trailing":   func() error { _, err := decodeGraphEntrySnapshot(append(graphEntry, 0)); return err },
+		"graph entry huge count": func() error { _, err := decodeGraphEntrySnapshot(hugeCount.Bytes()); return err },
+		"graph entry content":    func() error { _, err := decodeGraphEntrySnapshot(shortGraphContent.Bytes()); return err },
+	}
+
+	for name, decode := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := decode(); !errors.Is(err, ErrMalformedResult) {
+				t.Fatalf("Expected ErrMalformedResult, got %v", err)
+			}
+		})
+	}
+}
+
+func TestPointerTargetKindString(t *testing.T) {
+	if got := PointerTargetGraphEntry.String(); got != "graph entry" {
+		t.Fatalf("String() = %q", got)
+	}
+	if got := PointerTargetKind(0).String(); got != "PointerTargetKind(0)" {
+		t.Fatalf("String() = %q", got)
+	}
+}
+
+func BenchmarkDecodePointerSnapshot(b *testing.B) {
+	data := buildPointerSnapshot(testPointerSnapshot)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := decodePointerSnapshot(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeGraphEntrySnapshot(b *testing.B) {
+	data := buildGraphEntrySnapshot(testGraphEntrySnapshot)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := decodeGraphEntrySnapshot(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -42,31 +42,94 @@ import (
 
 // DataStream provides memory-efficient streaming for large data.
 // Use NextChunk() to iterate through chunks, or CollectAll() to get all data at once.
+// NewDataReader wraps it in the io.Reader, io.ReaderAt and io.Seeker interfaces.
+//
+// A stream opened with StreamOptions.ReadAhead fetches blocks for NextChunk in
+// the background. Free it, or cancel the context it was opened with, to stop
+// the read-ahead.
+//
+// GetRange is safe for concurrent use, and concurrent ranges are fetched in
+// parallel, including the blocks fetched by read-ahead.
 type DataStream struct {
-	handle unsafe.Pointer
-	freed  bool
-	mu     sync.Mutex
+	handle    unsafe.Pointer
+	freed     bool
+	calls     callRefs // GetRange calls, which run without holding mu
+	mu        sync.Mutex
+	readAhead *readAhead
+}
+
+// dataStreamOps are the native DataStream calls GetRange makes without
+// holding the stream's lock. Tests replace them to observe concurrent ranges.
+var dataStreamOps = struct {
+	clone    func(unsafe.Pointer) (unsafe.Pointer, error)
+	free     func(unsafe.Pointer)
+	getRange func(handle unsafe.Pointer, start, length uint64) ([]byte, error)
+}{
+	clone: func(handle unsafe.Pointer) (unsafe.Pointer, error) {
+		var status C.RustCallStatus
+		cloned := C.uniffi_ant_ffi_fn_clone_datastream(handle, &status)
+		if err := checkStatus(&status, "clone datastream", nil); err != nil {
+			return nil, err
+		}
+		return cloned, nil
+	},
+	free: func(handle unsafe.Pointer) {
+		var status C.RustCallStatus
+		C.uniffi_ant_ffi_fn_free_datastream(handle, &status)
+	},
+	getRange: func(handle unsafe.Pointer, start, length uint64) ([]byte, error) {
+		var status C.RustCallStatus
+		result := C.uniffi_ant_ffi_fn_method_datastream_get_range(
+			handle,
+			C.uint64_t(start),
+			C.uint64_t(length),
+			&status,
+		)
+		if err := checkStatus(&status, "get_range", liftClientError); err != nil {
+			return nil, err
+		}
+		return fromRustBuffer(result, true), nil
+	},
 }
 
 func newDataStream(handle unsafe.Pointer) *DataStream {
 	s := &DataStream{handle: handle}
 	runtime.SetFinalizer(s, (*DataStream).Free)
+	trackHandle(unsafe.Pointer(s), "DataStream", s.handle)
 	return s
 }
 
 // Free releases the DataStream resources.
+// Calls made after Free fail with ErrDisposed; GetRange calls already in
+// flight keep the native handle alive until they return.
 func (s *DataStream) Free() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.freed || s.handle == nil {
 		return
 	}
-	var status C.RustCallStatus
-	C.uniffi_ant_ffi_fn_free_datastream(s.handle, &status)
+	if s.readAhead != nil {
+		s.readAhead.stop()
+	}
 	s.freed = true
+	if s.calls.close() {
+		s.releaseHandle()
+	}
+}
+
+// releaseHandle frees the native handle. s.mu must be held.
+func (s *DataStream) releaseHandle() {
+	dataStreamOps.free(s.handle)
+	untrackHandle(unsafe.Pointer(s))
 	s.handle = nil
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (s *DataStream) Close() error {
+	s.Free()
+	return nil
+}
+
 // CloneHandle returns a cloned handle for FFI calls.
 func (s *DataStream) CloneHandle() unsafe.Pointer {
 	s.mu.Lock()
@@ -80,21 +143,30 @@ func (s *DataStream) CloneHandle() unsafe.Pointer {
 
 // NextChunk returns the next chunk of data from the stream.
 // Returns nil when the stream is exhausted.
+//
+// With read-ahead enabled it returns the prefetched blocks instead, each
+// StreamOptions.ReadAheadBlockSize bytes except the last.
 func (s *DataStream) NextChunk() ([]byte, error) {
 	s.mu.Lock()
-	defer s.mu.Unlock()
 	if s.freed || s.handle == nil {
+		s.mu.Unlock()
 		return nil, ErrDisposed
 	}
+	if ra := s.readAhead; ra != nil {
+		// The read-ahead fetches through GetRange, so wait for it unlocked.
+		s.mu.Unlock()
+		return ra.next()
+	}
+	defer s.mu.Unlock()
 
 	var status C.RustCallStatus
 	cloned := C.uniffi_ant_ffi_fn_clone_datastream(s.handle, &status)
-	if err := checkStatus(&status, "clone datastream"); err != nil {
+	if err := checkStatus(&status, "clone datastream", nil); err != nil {
 		return nil, err
 	}
 
 	result := C.uniffi_ant_ffi_fn_method_datastream_next_chunk(cloned, &status)
-	if err := checkStatus(&status, "next_chunk"); err != nil {
+	if err := checkStatus(&status, "next_chunk", liftClientError); err != nil {
 		return nil, err
 	}
 
@@ -132,12 +204,12 @@ func (s *DataStream) CollectAll() ([]byte, error) {
 
 	var status C.RustCallStatus
 	cloned := C.uniffi_ant_ffi_fn_clone_datastream(s.handle, &status)
-	if err := checkStatus(&status, "clone datastream"); err != nil {
+	if err := checkStatus(&status, "clone datastream", nil); err != nil {
 		return nil, err
 	}
 
 	result := C.uniffi_ant_ffi_fn_method_datastream_collect_all(cloned, &status)
-	if err := checkStatus(&status, "collect_all"); err != nil {
+	if err := checkStatus(&status, "collect_all", liftClientError); err != nil {
 		return nil, err
 	}
 
@@ -154,12 +226,12 @@ func (s *DataStream) DataSize() (uint64, error) {
 
 	var status C.RustCallStatus
 	cloned := C.uniffi_ant_ffi_fn_clone_datastream(s.handle, &status)
-	if err := checkStatus(&status, "clone datastream"); err != nil {
+	if err := checkStatus(&status, "clone datastream", nil); err != nil {
 		return 0, err
 	}
 
 	size := C.uniffi_ant_ffi_fn_method_datastream_data_size(cloned, &status)
-	if err := checkStatus(&status, "data_size"); err != nil {
+	if err := checkStatus(&status, "data_size", liftClientError); err != nil {
 		return 0, err
 	}
 
@@ -167,90 +239,133 @@ func (s *DataStream) DataSize() (uint64, error) {
 }
 
 // GetRange decrypts and returns a specific byte range from the encrypted data.
+// It does not hold the stream's lock while fetching, so concurrent calls
+// overlap.
 func (s *DataStream) GetRange(start, length uint64) ([]byte, error) {
+	cloned, err := s.beginCall()
+	if err != nil {
+		return nil, err
+	}
+	defer s.endCall()
+
+	return dataStreamOps.getRange(cloned, start, length)
+}
+
+// beginCall starts an in-flight GetRange and returns a cloned handle for it.
+func (s *DataStream) beginCall() (unsafe.Pointer, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.freed || s.handle == nil {
 		return nil, ErrDisposed
 	}
-
-	var status C.RustCallStatus
-	cloned := C.uniffi_ant_ffi_fn_clone_datastream(s.handle, &status)
-	if err := checkStatus(&status, "clone datastream"); err != nil {
+	if err := s.calls.begin(); err != nil {
 		return nil, err
 	}
-
-	result := C.uniffi_ant_ffi_fn_method_datastream_get_range(
-		cloned,
-		C.uint64_t(start),
-		C.uint64_t(length),
-		&status,
-	)
-	if err := checkStatus(&status, "get_range"); err != nil {
+	cloned, err := dataStreamOps.clone(s.handle)
+	if err != nil {
+		s.calls.end()
 		return nil, err
 	}
+	return cloned, nil
+}
 
-	return fromRustBuffer(result, true), nil
+// endCall ends a GetRange started by beginCall, releasing the native handle
+// if Free was called while it ran.
+func (s *DataStream) endCall() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.calls.end() {
+		s.releaseHandle()
+	}
 }
 
-// DataStream creates a stream for reading private data in chunks.
-// Use this for large data to avoid loading everything into memory.
-func (c *Client) DataStream(ctx context.Context, dataMap *DataMapChunk) (*DataStream, error) {
-	c.mu.Lock()
-	if c.freed || c.handle == nil {
-		c.mu.Unlock()
-		return nil, ErrDisposed
+// ReadAheadStats returns the stream's read-ahead statistics. They are zero if
+// the stream was opened without read-ahead.
+func (s *DataStream) ReadAheadStats() ReadAheadStats {
+	s.mu.Lock()
+	ra := s.readAhead
+	s.mu.Unlock()
+	if ra == nil {
+		return ReadAheadStats{}
 	}
+	return ra.stats()
+}
 
-	var status C.RustCallStatus
-	cloned := C.uniffi_ant_ffi_fn_clone_client(c.handle, &status)
-	if err := checkStatus(&status, "clone client"); err != nil {
-		c.mu.Unlock()
+// startReadAhead begins prefetching blocks for NextChunk if options enable it.
+func (s *DataStream) startReadAhead(ctx context.Context, options StreamOptions) error {
+	if options.ReadAhead <= 0 {
+		return nil
+	}
+	size, err := s.DataSize()
+	if err != nil {
+		return err
+	}
+	window, blockSize := options.readAheadWindow()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.readAhead = startReadAhead(ctx, s, size, window, blockSize)
+	return nil
+}
+
+// openDataStream wraps a stream handle and starts its read-ahead.
+func openDataStream(ctx context.Context, ptr unsafe.Pointer, options []StreamOptions) (*DataStream, error) {
+	stream := newDataStream(ptr)
+	if err := stream.startReadAhead(ctx, resolveStreamOptions(options)); err != nil {
+		stream.Free()
 		return nil, err
 	}
-	c.mu.Unlock()
+	return stream, nil
+}
+
+// DataStream creates a stream for reading private data in chunks.
+// Use this for large data to avoid loading everything into memory.
+// options may enable read-ahead; see StreamOptions.
+func (c *Client) DataStream(ctx context.Context, dataMap *DataMapChunk, options ...StreamOptions) (*DataStream, error) {
+	cloned, err := c.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer c.release()
 
 	dataMapCloned := dataMap.CloneHandle()
 	if dataMapCloned == nil {
+		c.freeClone(cloned)
 		return nil, ErrDisposed
 	}
 
+	consumeClones(cloned, dataMapCloned)
 	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_data_stream(cloned, dataMapCloned))
 	ptr, err := pollPointerFuture(ctx, futureHandle)
 	if err != nil {
 		return nil, err
 	}
 
-	return newDataStream(ptr), nil
+	return openDataStream(ctx, ptr, options)
 }
 
 // DataStreamPublic creates a stream for reading public data in chunks.
 // Use this for large data to avoid loading everything into memory.
-func (c *Client) DataStreamPublic(ctx context.Context, address *DataAddress) (*DataStream, error) {
-	c.mu.Lock()
-	if c.freed || c.handle == nil {
-		c.mu.Unlock()
-		return nil, ErrDisposed
-	}
-
-	var status C.RustCallStatus
-	cloned := C.uniffi_ant_ffi_fn_clone_client(c.handle, &status)
-	if err := checkStatus(&status, "clone client"); err != nil {
-		c.mu.Unlock()
+// options may enable read-ahead; see StreamOptions.
+func (c *Client) DataStreamPublic(ctx context.Context, address *DataAddress, options ...StreamOptions) (*DataStream, error) {
+	cloned, err := c.acquire()
+	if err != nil {
 		return nil, err
 	}
-	c.mu.Unlock()
+	defer c.release()
 
 	addressCloned := address.CloneHandle()
 	if addressCloned == nil {
+		c.freeClone(cloned)
 		return nil, ErrDisposed
 	}
 
+	consumeClones(cloned, addressCloned)
 	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_client_data_stream_public(cloned, addressCloned))
 	ptr, err := pollPointerFuture(ctx, futureHandle)
 	if err != nil {
 		return nil, err
 	}
 
-	return newDataStream(ptr), nil
+	return openDataStream(ctx, ptr, options)
 }
@@ -0,0 +1,182 @@
+package antffi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ClientOptions holds the default timeouts a Client applies to each class of
+// operation when the caller's context has no deadline, and its upload settings.
+//
+// Zero fields take their value from DefaultClientOptions, so options can set
+// a single timeout. A negative timeout leaves that class bounded only by the
+// caller's context.
+type ClientOptions struct {
+	// ConnectTimeout bounds client construction and bootstrapping.
+	ConnectTimeout time.Duration
+	// ReadTimeout bounds gets and existence checks.
+	ReadTimeout time.Duration
+	// WriteTimeout bounds puts, creates and updates.
+	WriteTimeout time.Duration
+	// QuoteTimeout bounds cost quotes.
+	QuoteTimeout time.Duration
+	// TransferTimeout bounds file and directory uploads and downloads, and
+	// uploads from an io.Reader.
+	TransferTimeout time.Duration
+
+	// UploadWindow is how many bytes DataPutReader hands to Rust at a time,
+	// which bounds its memory use. Zero means DefaultUploadWindow.
+	UploadWindow int
+}
+
+// DefaultClientOptions returns the options used when none are given.
+func DefaultClientOptions() ClientOptions {
+	return ClientOptions{
+		ConnectTimeout:  2 * time.Minute,
+		ReadTimeout:     2 * time.Minute,
+		WriteTimeout:    5 * time.Minute,
+		QuoteTimeout:    time.Minute,
+		TransferTimeout: 30 * time.Minute,
+		UploadWindow:    DefaultUploadWindow,
+	}
+}
+
+// resolveClientOptions returns the last of opts merged over the defaults, or
+// the defaults if none are given.
+func resolveClientOptions(opts []ClientOptions) ClientOptions {
+	if len(opts) == 0 {
+		return DefaultClientOptions()
+	}
+	return opts[len(opts)-1].withDefaults()
+}
+
+// withDefaults returns o with each zero field replaced by its default.
+func (o ClientOptions) withDefaults() ClientOptions {
+	defaults := DefaultClientOptions()
+	if o.ConnectTimeout == 0 {
+		o.ConnectTimeout = defaults.ConnectTimeout
+	}
+	if o.ReadTimeout == 0 {
+		o.ReadTimeout = defaults.ReadTimeout
+	}
+	if o.WriteTimeout == 0 {
+		o.WriteTimeout = defaults.WriteTimeout
+	}
+	if o.QuoteTimeout == 0 {
+		o.QuoteTimeout = defaults.QuoteTimeout
+	}
+	if o.TransferTimeout == 0 {
+		o.TransferTimeout = defaults.TransferTimeout
+	}
+	if o.UploadWindow == 0 {
+		o.UploadWindow = defaults.UploadWindow
+	}
+	return o
+}
+
+// operationClass groups Client operations that share a default timeout.
+type operationClass int
+
+const (
+	opConnect operationClass = iota
+	opRead
+	opWrite
+	opQuote
+	opTransfer
+)
+
+// timeout returns the default timeout for an operation class.
+func (o ClientOptions) timeout(class operationClass) time.Duration {
+	switch class {
+	case opConnect:
+		return o.ConnectTimeout
+	case opRead:
+		return o.ReadTimeout
+	case opWrite:
+		return o.WriteTimeout
+	case opQuote:
+		return o.QuoteTimeout
+	case opTransfer:
+		return o.TransferTimeout
+	}
+	return 0
+}
+
+// TimeoutError is returned when an operation exceeds a default timeout from
+// ClientOptions. It matches ErrTimeout and context.DeadlineExceeded.
+type TimeoutError struct {
+	Op      string
+	Timeout time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("%s timed out after %s", e.Op, e.Timeout)
+}
+
+func (e *TimeoutError) Is(target error) bool {
+	return target == context.DeadlineExceeded
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return ErrTimeout
+}
+
+type defaultTimeoutKey struct{}
+
+// defaultTimeout records a deadline applied by withDefaultTimeout.
+type defaultTimeout struct {
+	ctx     context.Context
+	op      string
+	timeout time.Duration
+}
+
+// withDefaultTimeout bounds ctx by the default timeout for class when ctx has
+// no deadline of its own. The returned cancel func must always be called.
+func withDefaultTimeout(ctx context.Context, options ClientOptions, class operationClass, op string) (context.Context, context.CancelFunc) {
+	timeout := options.timeout(class)
+	if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
+	return context.WithValue(deadlineCtx, defaultTimeoutKey{}, &defaultTimeout{
+		ctx:     deadlineCtx,
+		op:      op,
+		timeout: timeout,
+	}), cancel
+}
+
+// contextError returns ctx.Err(), or a *TimeoutError when the error is a
+// default timeout applied by withDefaultTimeout.
+func contextError(ctx context.Context) error {
+	err := ctx.Err()
+	if !errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	if t, ok := ctx.Value(defaultTimeoutKey{}).(*defaultTimeout); ok && t.ctx.Err() != nil {
+		return &TimeoutError{Op: t.op, Timeout: t.timeout}
+	}
+	return err
+}
+
+// SetOptions replaces the client's default timeouts and upload settings.
+// Zero fields in options take their default values.
+func (c *Client) SetOptions(options ClientOptions) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.options = options.withDefaults()
+}
+
+// Options returns the client's default timeouts.
+func (c *Client) Options() ClientOptions {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.options
+}
+
+// withTimeout applies the client's default timeout for class to ctx.
+func (c *Client) withTimeout(ctx context.Context, class operationClass, op string) (context.Context, context.CancelFunc) {
+	return withDefaultTimeout(ctx, c.Options(), class, op)
+}
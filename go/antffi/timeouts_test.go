@@ -0,0 +1,134 @@
+package antffi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDefaultTimeoutFires(t *testing.T) {
+	options := ClientOptions{ReadTimeout: 10 * time.Millisecond}
+	fake := newFakeFuture(0, true)
+
+	ctx, cancel := withDefaultTimeout(context.Background(), options, opRead, "ChunkGet")
+	defer cancel()
+
+	_, err := driveFuture(ctx, 1, fake)
+	if !errors.Is(err, ErrTimeout) {
+		t.Fatalf("Expected ErrTimeout, got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected TimeoutError to match context.DeadlineExceeded: %v", err)
+	}
+
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) || timeoutErr.Op != "ChunkGet" || timeoutErr.Timeout != 10*time.Millisecond {
+		t.Fatalf("Unexpected timeout error: %v", err)
+	}
+	if fake.cancels != 1 || fake.frees != 1 {
+		t.Fatalf("Expected the Rust future cancelled, got cancels=%d frees=%d", fake.cancels, fake.frees)
+	}
+}
+
+func TestDefaultTimeoutRespectsCallerDeadline(t *testing.T) {
+	options := ClientOptions{WriteTimeout: time.Hour}
+
+	parent, cancelParent := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelParent()
+
+	ctx, cancel := withDefaultTimeout(parent, options, opWrite, "ChunkPut")
+	defer cancel()
+
+	_, err := driveFuture(ctx, 1, newFakeFuture(0, true))
+	if errors.Is(err, ErrTimeout) {
+		t.Fatalf("Caller deadline should not be reported as a default timeout: %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestDefaultTimeoutClasses(t *testing.T) {
+	options := ClientOptions{
+		ConnectTimeout:  1,
+		ReadTimeout:     2,
+		WriteTimeout:    3,
+		QuoteTimeout:    4,
+		TransferTimeout: 5,
+	}
+
+	for class, want := range map[operationClass]time.Duration{
+		opConnect: 1, opRead: 2, opWrite: 3, opQuote: 4, opTransfer: 5,
+	} {
+		if got := options.timeout(class); got != want {
+			t.Fatalf("timeout(%d) = %v, want %v", class, got, want)
+		}
+	}
+
+	ctx, cancel := withDefaultTimeout(context.Background(), ClientOptions{}, opRead, "DataGet")
+	defer cancel()
+	if _, ok := ctx.Deadline(); ok {
+		t.Fatal("Zero timeout should not add a deadline")
+	}
+}
+
+func TestDefaultTimeoutWithRetry(t *testing.T) {
+	options := ClientOptions{ReadTimeout: 20 * time.Millisecond}
+	ctx, cancel := withDefaultTimeout(context.Background(), options, opRead, "PointerGet")
+	defer cancel()
+
+	policy := fastRetryPolicy(100)
+	policy.AttemptTimeout = 5 * time.Millisecond
+
+	_, err := runWithRetry(ctx, policy, func(ctx context.Context) (futureOutcome, error) {
+		return driveFuture(ctx, 1, newFakeFuture(0, true))
+	})
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) || timeoutErr.Op != "PointerGet" {
+		t.Fatalf("Expected the operation to stop at its default timeout, got %v", err)
+	}
+}
+
+func TestResolveClientOptions(t *testing.T) {
+	if got := resolveClientOptions(nil); got != DefaultClientOptions() {
+		t.Fatalf("Expected defaults, got %+v", got)
+	}
+
+	// Fields left at zero keep their defaults.
+	want := DefaultClientOptions()
+	want.ReadTimeout = time.Second
+	if got := resolveClientOptions([]ClientOptions{{ReadTimeout: time.Second}}); got != want {
+		t.Fatalf("Expected defaults with the read timeout set, got %+v", got)
+	}
+
+	// The last options given win.
+	want.ReadTimeout = 2 * time.Second
+	if got := resolveClientOptions([]ClientOptions{{ReadTimeout: time.Second}, {ReadTimeout: 2 * time.Second}}); got != want {
+		t.Fatalf("Expected the last options, got %+v", got)
+	}
+}
+
+func TestClientOptionsNegativeTimeoutDisables(t *testing.T) {
+	options := resolveClientOptions([]ClientOptions{{WriteTimeout: -1}})
+	if options.WriteTimeout != -1 || options.ReadTimeout != DefaultClientOptions().ReadTimeout {
+		t.Fatalf("Unexpected options %+v", options)
+	}
+
+	ctx, cancel := withDefaultTimeout(context.Background(), options, opWrite, "ChunkPut")
+	defer cancel()
+	if _, ok := ctx.Deadline(); ok {
+		t.Fatal("A negative timeout applied a deadline")
+	}
+}
+
+func TestClientSetOptionsMergesDefaults(t *testing.T) {
+	c := &Client{}
+	c.SetOptions(ClientOptions{QuoteTimeout: time.Second})
+
+	want := DefaultClientOptions()
+	want.QuoteTimeout = time.Second
+	if got := c.Options(); got != want {
+		t.Fatalf("Expected defaults with the quote timeout set, got %+v", got)
+	}
+}
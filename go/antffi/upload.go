@@ -0,0 +1,179 @@
+package antffi
+
+/*
+#include <stdint.h>
+
+typedef struct {
+    uint64_t capacity;
+    uint64_t len;
+    uint8_t* data;
+} RustBuffer;
+
+typedef struct {
+    int8_t code;
+    RustBuffer error_buf;
+} RustCallStatus;
+
+// DataUpload lifecycle
+extern void* uniffi_ant_ffi_fn_clone_dataupload(void* ptr, RustCallStatus* status);
+extern void uniffi_ant_ffi_fn_free_dataupload(void* ptr, RustCallStatus* status);
+
+// DataUpload methods
+extern void uniffi_ant_ffi_fn_method_dataupload_write(void* ptr, RustBuffer data, RustCallStatus* status);
+extern uint64_t uniffi_ant_ffi_fn_method_dataupload_finish(void* ptr, RustBuffer payment);
+extern uint64_t uniffi_ant_ffi_fn_method_dataupload_finish_public(void* ptr, RustBuffer payment);
+
+// Client upload methods
+extern void* uniffi_ant_ffi_fn_method_client_data_upload(void* ptr, uint64_t size, RustCallStatus* status);
+*/
+import "C"
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// DefaultUploadWindow is the upload window used when ClientOptions.UploadWindow is zero.
+const DefaultUploadWindow = 4 << 20
+
+// uploadWindow returns the number of bytes DataPutReader buffers per write.
+func (o ClientOptions) uploadWindow() int {
+	if o.UploadWindow <= 0 {
+		return DefaultUploadWindow
+	}
+	return o.UploadWindow
+}
+
+// DataPutReader uploads size bytes read from r as private data.
+//
+// The data is handed to Rust one window at a time (see ClientOptions.UploadWindow)
+// and spooled to a temporary file there, so memory use does not grow with size.
+// r must yield at least size bytes; anything after them is not read.
+func (c *Client) DataPutReader(ctx context.Context, r io.Reader, size int64, payment *PaymentOption) (*DataPutResult, error) {
+	ctx, cancel := c.withTimeout(ctx, opTransfer, "DataPutReader")
+	defer cancel()
+
+	buf, err := c.uploadReader(ctx, r, size, payment, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeDataPutResult(fromRustBufferRaw(buf, true))
+}
+
+// DataPutPublicReader uploads size bytes read from r as public data.
+// Memory use is bounded as for DataPutReader.
+func (c *Client) DataPutPublicReader(ctx context.Context, r io.Reader, size int64, payment *PaymentOption) (*UploadResult, error) {
+	ctx, cancel := c.withTimeout(ctx, opTransfer, "DataPutPublicReader")
+	defer cancel()
+
+	buf, err := c.uploadReader(ctx, r, size, payment, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deserialize UploadResult record
+	reader := NewUniFFIReader(fromRustBufferRaw(buf, true))
+	price := reader.ReadString()
+	address := reader.ReadString()
+
+	return &UploadResult{Price: price, Address: address}, nil
+}
+
+// uploadReader feeds size bytes from r to a Rust DataUpload and finishes it,
+// returning the serialized result record.
+func (c *Client) uploadReader(ctx context.Context, r io.Reader, size int64, payment *PaymentOption, public bool) (C.RustBuffer, error) {
+	if r == nil {
+		return C.RustBuffer{}, ErrNilPointer
+	}
+	if size < 0 {
+		return C.RustBuffer{}, fmt.Errorf("%w: negative size %d", ErrInvalidArgument, size)
+	}
+
+	cloned, err := c.acquire()
+	if err != nil {
+		return C.RustBuffer{}, err
+	}
+	defer c.release()
+
+	var status C.RustCallStatus
+	consumeClones(cloned)
+	upload := C.uniffi_ant_ffi_fn_method_client_data_upload(cloned, C.uint64_t(size), &status)
+	if err := checkStatus(&status, "DataUpload", liftClientError); err != nil {
+		return C.RustBuffer{}, err
+	}
+	defer func() {
+		var status C.RustCallStatus
+		C.uniffi_ant_ffi_fn_free_dataupload(upload, &status)
+	}()
+
+	err = feedUpload(ctx, r, size, c.Options().uploadWindow(), func(piece []byte) error {
+		var cloneStatus C.RustCallStatus
+		clonedUpload := C.uniffi_ant_ffi_fn_clone_dataupload(upload, &cloneStatus)
+		if err := checkStatus(&cloneStatus, "clone dataupload", nil); err != nil {
+			return err
+		}
+
+		var status C.RustCallStatus
+		C.uniffi_ant_ffi_fn_method_dataupload_write(clonedUpload, rawToRustBuffer(piece), &status)
+		return checkStatus(&status, "DataUpload.Write", liftClientError)
+	})
+	if err != nil {
+		return C.RustBuffer{}, err
+	}
+
+	var cloneStatus C.RustCallStatus
+	clonedUpload := C.uniffi_ant_ffi_fn_clone_dataupload(upload, &cloneStatus)
+	if err := checkStatus(&cloneStatus, "clone dataupload", nil); err != nil {
+		return C.RustBuffer{}, err
+	}
+	paymentBuffer := getPaymentBuffer(payment)
+
+	var futureHandle uint64
+	if public {
+		futureHandle = uint64(C.uniffi_ant_ffi_fn_method_dataupload_finish_public(clonedUpload, paymentBuffer))
+	} else {
+		futureHandle = uint64(C.uniffi_ant_ffi_fn_method_dataupload_finish(clonedUpload, paymentBuffer))
+	}
+	return pollRustBufferFuture(ctx, futureHandle)
+}
+
+// feedUpload reads exactly size bytes from r and passes them to write in
+// pieces of at most window bytes. Every piece is UniFFI-serialized as a
+// Vec<u8> (length prefix and bytes) in a single reused buffer, so it can be
+// lowered with rawToRustBuffer and peak memory stays near one window.
+func feedUpload(ctx context.Context, r io.Reader, size int64, window int, write func(piece []byte) error) error {
+	if window <= 0 {
+		window = DefaultUploadWindow
+	}
+	if int64(window) > size {
+		window = int(size)
+	}
+
+	buf := make([]byte, 4+window)
+	for remaining := size; remaining > 0; {
+		if err := ctx.Err(); err != nil {
+			return contextError(ctx)
+		}
+
+		n := window
+		if int64(n) > remaining {
+			n = int(remaining)
+		}
+		if _, err := io.ReadFull(r, buf[4:4+n]); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return fmt.Errorf("reading upload data: %w", err)
+		}
+
+		binary.BigEndian.PutUint32(buf[0:4], uint32(n))
+		if err := write(buf[:4+n]); err != nil {
+			return err
+		}
+		remaining -= int64(n)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package antffi
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// patternReader yields size bytes of a repeating pattern without holding them.
+type patternReader struct {
+	size, off int64
+}
+
+func patternByte(off int64) byte {
+	return byte(off*7 + off/251)
+}
+
+func (p *patternReader) Read(b []byte) (int, error) {
+	if p.off >= p.size {
+		return 0, io.EOF
+	}
+	if remaining := p.size - p.off; int64(len(b)) > remaining {
+		b = b[:remaining]
+	}
+	for i := range b {
+		b[i] = patternByte(p.off + int64(i))
+	}
+	p.off += int64(len(b))
+	return len(b), nil
+}
+
+func TestFeedUploadBoundedMemory(t *testing.T) {
+	const (
+		size   = 64 << 20
+		window = 64 << 10
+	)
+
+	var pieces int
+	var total int64
+	write := func(piece []byte) error {
+		if len(piece) > 4+window {
+			t.Fatalf("Piece of %d bytes exceeds the window", len(piece))
+		}
+		n := int(binary.BigEndian.Uint32(piece[:4]))
+		if n != len(piece)-4 {
+			t.Fatalf("Length prefix %d does not match piece of %d bytes", n, len(piece)-4)
+		}
+		for i, b := range piece[4:] {
+			if b != patternByte(total+int64(i)) {
+				t.Fatalf("Byte %d differs", total+int64(i))
+			}
+		}
+		pieces++
+		total += int64(n)
+		return nil
+	}
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	if err := feedUpload(context.Background(), &patternReader{size: size}, size, window, write); err != nil {
+		t.Fatalf("feedUpload failed: %v", err)
+	}
+	runtime.ReadMemStats(&after)
+
+	if total != size || pieces != size/window {
+		t.Fatalf("Wrote %d bytes in %d pieces, want %d in %d", total, pieces, size, size/window)
+	}
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 4*window {
+		t.Fatalf("Uploading %d bytes allocated %d bytes, want at most %d", size, allocated, 4*window)
+	}
+}
+
+func TestFeedUploadWindow(t *testing.T) {
+	data := []byte("small upload")
+
+	var got bytes.Buffer
+	var largest int
+	err := feedUpload(context.Background(), bytes.NewReader(data), int64(len(data)), 0, func(piece []byte) error {
+		if len(piece) > largest {
+			largest = len(piece)
+		}
+		got.Write(piece[4:])
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("feedUpload failed: %v", err)
+	}
+	if got.String() != string(data) || largest != 4+len(data) {
+		t.Fatalf("Got %q in pieces of up to %d bytes", got.String(), largest)
+	}
+
+	calls := 0
+	err = feedUpload(context.Background(), strings.NewReader(""), 0, 1024, func([]byte) error {
+		calls++
+		return nil
+	})
+	if err != nil || calls != 0 {
+		t.Fatalf("Empty upload = %v after %d writes", err, calls)
+	}
+}
+
+func TestFeedUploadShortReader(t *testing.T) {
+	err := feedUpload(context.Background(), strings.NewReader("too short"), 100, 16, func([]byte) error {
+		return nil
+	})
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected io.ErrUnexpectedEOF, got %v", err)
+	}
+}
+
+func TestFeedUploadWriteError(t *testing.T) {
+	writeErr := &ClientError{Kind: ClientInvalidInput, Reason: "too much data"}
+	err := feedUpload(context.Background(), &patternReader{size: 100}, 100, 16, func([]byte) error {
+		return writeErr
+	})
+	if err != writeErr {
+		t.Fatalf("Expected the write error, got %v", err)
+	}
+}
+
+func TestFeedUploadCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	writes := 0
+	err := feedUpload(ctx, &patternReader{size: 1 << 20}, 1<<20, 1024, func([]byte) error {
+		writes++
+		if writes == 3 {
+			cancel()
+		}
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) || writes != 3 {
+		t.Fatalf("Expected cancellation after 3 writes, got %v after %d", err, writes)
+	}
+}
+
+func TestUploadWindowOption(t *testing.T) {
+	if w := (ClientOptions{}).uploadWindow(); w != DefaultUploadWindow {
+		t.Fatalf("Zero UploadWindow = %d, want %d", w, DefaultUploadWindow)
+	}
+	if w := (ClientOptions{UploadWindow: 1024}).uploadWindow(); w != 1024 {
+		t.Fatalf("UploadWindow = %d, want 1024", w)
+	}
+	if w := DefaultClientOptions().UploadWindow; w != DefaultUploadWindow {
+		t.Fatalf("DefaultClientOptions UploadWindow = %d, want %d", w, DefaultUploadWindow)
+	}
+}
+
+func TestDataPutReaderArguments(t *testing.T) {
+	c := &Client{}
+	ctx := context.Background()
+
+	if _, err := c.DataPutReader(ctx, strings.NewReader("data"), 4, nil); !errors.Is(err, ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed, got %v", err)
+	}
+	if _, err := c.DataPutPublicReader(ctx, nil, 4, nil); !errors.Is(err, ErrNilPointer) {
+		t.Fatalf("Expected ErrNilPointer, got %v", err)
+	}
+	if _, err := c.DataPutReader(ctx, strings.NewReader("data"), -1, nil); !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("Expected ErrInvalidArgument, got %v", err)
+	}
+}
@@ -25,6 +25,12 @@ extern void* uniffi_ant_ffi_fn_clone_vaultsecretkey(void* ptr, RustCallStatus* s
 extern void* uniffi_ant_ffi_fn_constructor_userdata_new(RustCallStatus* status);
 extern RustBuffer uniffi_ant_ffi_fn_method_userdata_file_archives(void* ptr, RustCallStatus* status);
 extern RustBuffer uniffi_ant_ffi_fn_method_userdata_private_file_archives(void* ptr, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_method_userdata_add_file_archive(void* ptr, void* address, RustBuffer name, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_method_userdata_add_private_file_archive(void* ptr, void* dataMap, RustBuffer name, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_method_userdata_remove_file_archive(void* ptr, void* address, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_method_userdata_remove_private_file_archive(void* ptr, void* dataMap, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_method_userdata_rename_file_archive(void* ptr, void* address, RustBuffer name, RustCallStatus* status);
+extern void* uniffi_ant_ffi_fn_method_userdata_rename_private_file_archive(void* ptr, void* dataMap, RustBuffer name, RustCallStatus* status);
 extern void uniffi_ant_ffi_fn_free_userdata(void* ptr, RustCallStatus* status);
 extern void* uniffi_ant_ffi_fn_clone_userdata(void* ptr, RustCallStatus* status);
 */
@@ -48,7 +54,7 @@ func NewVaultSecretKey() (*VaultSecretKey, error) {
 	var status C.RustCallStatus
 	handle := C.uniffi_ant_ffi_fn_constructor_vaultsecretkey_random(&status)
 
-	if err := checkStatus(&status, "VaultSecretKey.Random"); err != nil {
+	if err := checkStatus(&status, "VaultSecretKey.Random", liftVaultError); err != nil {
 		return nil, err
 	}
 
@@ -62,7 +68,7 @@ func VaultSecretKeyFromHex(hex string) (*VaultSecretKey, error) {
 
 	handle := C.uniffi_ant_ffi_fn_constructor_vaultsecretkey_from_hex(hexBuffer, &status)
 
-	if err := checkStatus(&status, "VaultSecretKey.FromHex"); err != nil {
+	if err := checkStatus(&status, "VaultSecretKey.FromHex", liftVaultError); err != nil {
 		return nil, err
 	}
 
@@ -72,6 +78,7 @@ func VaultSecretKeyFromHex(hex string) (*VaultSecretKey, error) {
 func newVaultSecretKey(handle unsafe.Pointer) *VaultSecretKey {
 	vsk := &VaultSecretKey{handle: handle}
 	runtime.SetFinalizer(vsk, (*VaultSecretKey).Free)
+	trackHandle(unsafe.Pointer(vsk), "VaultSecretKey", vsk.handle)
 	return vsk
 }
 
@@ -85,9 +92,16 @@ func (vsk *VaultSecretKey) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_vaultsecretkey(vsk.handle, &status)
+	untrackHandle(unsafe.Pointer(vsk))
 	vsk.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (vsk *VaultSecretKey) Close() error {
+	vsk.Free()
+	return nil
+}
+
 func (vsk *VaultSecretKey) ToHex() (string, error) {
 	vsk.mu.Lock()
 	defer vsk.mu.Unlock()
@@ -98,9 +112,10 @@ func (vsk *VaultSecretKey) ToHex() (string, error) {
 
 	cloned := vsk.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_vaultsecretkey_to_hex(cloned, &status)
 
-	if err := checkStatus(&status, "VaultSecretKey.ToHex"); err != nil {
+	if err := checkStatus(&status, "VaultSecretKey.ToHex", liftVaultError); err != nil {
 		return "", err
 	}
 
@@ -109,7 +124,7 @@ func (vsk *VaultSecretKey) ToHex() (string, error) {
 
 func (vsk *VaultSecretKey) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_vaultsecretkey(vsk.handle, &status)
+	return trackClone("VaultSecretKey", C.uniffi_ant_ffi_fn_clone_vaultsecretkey(vsk.handle, &status))
 }
 
 func (vsk *VaultSecretKey) CloneHandle() unsafe.Pointer {
@@ -121,6 +136,22 @@ func (vsk *VaultSecretKey) CloneHandle() unsafe.Pointer {
 	return vsk.cloneHandle()
 }
 
+// FileArchiveEntry is a named public archive reference in UserData.
+type FileArchiveEntry struct {
+	// The hex-encoded address of the archive
+	Address string
+	// The user-defined name for this archive
+	Name string
+}
+
+// PrivateFileArchiveEntry is a named private archive reference in UserData.
+type PrivateFileArchiveEntry struct {
+	// The hex-encoded data map of the archive
+	DataMap string
+	// The user-defined name for this archive
+	Name string
+}
+
 // UserData represents user data stored in a vault.
 type UserData struct {
 	handle unsafe.Pointer
@@ -133,7 +164,7 @@ func NewUserData() (*UserData, error) {
 	var status C.RustCallStatus
 	handle := C.uniffi_ant_ffi_fn_constructor_userdata_new(&status)
 
-	if err := checkStatus(&status, "UserData.New"); err != nil {
+	if err := checkStatus(&status, "UserData.New", liftVaultError); err != nil {
 		return nil, err
 	}
 
@@ -143,6 +174,7 @@ func NewUserData() (*UserData, error) {
 func newUserData(handle unsafe.Pointer) *UserData {
 	ud := &UserData{handle: handle}
 	runtime.SetFinalizer(ud, (*UserData).Free)
+	trackHandle(unsafe.Pointer(ud), "UserData", ud.handle)
 	return ud
 }
 
@@ -156,12 +188,302 @@ func (ud *UserData) Free() {
 
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_userdata(ud.handle, &status)
+	untrackHandle(unsafe.Pointer(ud))
 	ud.freed = true
 }
 
+// Close implements io.Closer by calling Free. It always returns nil.
+func (ud *UserData) Close() error {
+	ud.Free()
+	return nil
+}
+
+// FileArchives returns the public archive references stored in the user data.
+func (ud *UserData) FileArchives() ([]FileArchiveEntry, error) {
+	ud.mu.Lock()
+	defer ud.mu.Unlock()
+
+	if ud.freed {
+		return nil, ErrDisposed
+	}
+
+	cloned := ud.cloneHandle()
+	var status C.RustCallStatus
+	consumeClones(cloned)
+	result := C.uniffi_ant_ffi_fn_method_userdata_file_archives(cloned, &status)
+
+	if err := checkStatus(&status, "UserData.FileArchives", liftVaultError); err != nil {
+		return nil, err
+	}
+
+	return decodeFileArchiveEntries(fromRustBufferRaw(result, true))
+}
+
+// decodeFileArchiveEntries decodes a Vec<FileArchiveEntry> (address: String, name: String).
+func decodeFileArchiveEntries(data []byte) ([]FileArchiveEntry, error) {
+	pairs, err := decodeStringPairs(data, "Vec<FileArchiveEntry>")
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]FileArchiveEntry, len(pairs))
+	for i, pair := range pairs {
+		entries[i] = FileArchiveEntry{Address: pair[0], Name: pair[1]}
+	}
+	return entries, nil
+}
+
+// PrivateFileArchives returns the private archive references stored in the user data.
+func (ud *UserData) PrivateFileArchives() ([]PrivateFileArchiveEntry, error) {
+	ud.mu.Lock()
+	defer ud.mu.Unlock()
+
+	if ud.freed {
+		return nil, ErrDisposed
+	}
+
+	cloned := ud.cloneHandle()
+	var status C.RustCallStatus
+	consumeClones(cloned)
+	result := C.uniffi_ant_ffi_fn_method_userdata_private_file_archives(cloned, &status)
+
+	if err := checkStatus(&status, "UserData.PrivateFileArchives", liftVaultError); err != nil {
+		return nil, err
+	}
+
+	return decodePrivateFileArchiveEntries(fromRustBufferRaw(result, true))
+}
+
+// decodePrivateFileArchiveEntries decodes a Vec<PrivateFileArchiveEntry> (data_map: String, name: String).
+func decodePrivateFileArchiveEntries(data []byte) ([]PrivateFileArchiveEntry, error) {
+	pairs, err := decodeStringPairs(data, "Vec<PrivateFileArchiveEntry>")
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]PrivateFileArchiveEntry, len(pairs))
+	for i, pair := range pairs {
+		entries[i] = PrivateFileArchiveEntry{DataMap: pair[0], Name: pair[1]}
+	}
+	return entries, nil
+}
+
+// decodeStringPairs decodes a sequence of records made of two strings.
+func decodeStringPairs(data []byte, record string) ([][2]string, error) {
+	reader := NewUniFFIReader(data)
+	count, ok := reader.readCount()
+	if !ok {
+		return nil, malformedRecord(record, len(data))
+	}
+
+	pairs := make([][2]string, 0, count)
+	for i := 0; i < count; i++ {
+		first, ok := reader.readString()
+		if !ok {
+			return nil, malformedRecord(record, len(data))
+		}
+		second, ok := reader.readString()
+		if !ok {
+			return nil, malformedRecord(record, len(data))
+		}
+		pairs = append(pairs, [2]string{first, second})
+	}
+
+	if reader.remaining() != 0 {
+		return nil, malformedRecord(record, len(data))
+	}
+	return pairs, nil
+}
+
+// AddFileArchive adds a named public archive reference and returns the updated user data.
+// An existing reference to the same archive is renamed.
+func (ud *UserData) AddFileArchive(address *ArchiveAddress, name string) (*UserData, error) {
+	if address == nil {
+		return nil, ErrNilPointer
+	}
+
+	ud.mu.Lock()
+	defer ud.mu.Unlock()
+
+	if ud.freed {
+		return nil, ErrDisposed
+	}
+
+	addressCloned := address.CloneHandle()
+	if addressCloned == nil {
+		return nil, ErrDisposed
+	}
+
+	cloned := ud.cloneHandle()
+	nameBuffer := stringToRustBuffer(name)
+	var status C.RustCallStatus
+	consumeClones(cloned, addressCloned)
+	handle := C.uniffi_ant_ffi_fn_method_userdata_add_file_archive(cloned, addressCloned, nameBuffer, &status)
+
+	if err := checkStatus(&status, "UserData.AddFileArchive", liftVaultError); err != nil {
+		return nil, err
+	}
+
+	return newUserData(handle), nil
+}
+
+// AddPrivateFileArchive adds a named private archive reference and returns the updated user data.
+// An existing reference to the same archive is renamed.
+func (ud *UserData) AddPrivateFileArchive(dataMap *PrivateArchiveDataMap, name string) (*UserData, error) {
+	if dataMap == nil {
+		return nil, ErrNilPointer
+	}
+
+	ud.mu.Lock()
+	defer ud.mu.Unlock()
+
+	if ud.freed {
+		return nil, ErrDisposed
+	}
+
+	dataMapCloned := dataMap.CloneHandle()
+	if dataMapCloned == nil {
+		return nil, ErrDisposed
+	}
+
+	cloned := ud.cloneHandle()
+	nameBuffer := stringToRustBuffer(name)
+	var status C.RustCallStatus
+	consumeClones(cloned, dataMapCloned)
+	handle := C.uniffi_ant_ffi_fn_method_userdata_add_private_file_archive(cloned, dataMapCloned, nameBuffer, &status)
+
+	if err := checkStatus(&status, "UserData.AddPrivateFileArchive", liftVaultError); err != nil {
+		return nil, err
+	}
+
+	return newUserData(handle), nil
+}
+
+// RemoveFileArchive removes a public archive reference and returns the updated user data.
+func (ud *UserData) RemoveFileArchive(address *ArchiveAddress) (*UserData, error) {
+	if address == nil {
+		return nil, ErrNilPointer
+	}
+
+	ud.mu.Lock()
+	defer ud.mu.Unlock()
+
+	if ud.freed {
+		return nil, ErrDisposed
+	}
+
+	addressCloned := address.CloneHandle()
+	if addressCloned == nil {
+		return nil, ErrDisposed
+	}
+
+	cloned := ud.cloneHandle()
+	var status C.RustCallStatus
+	consumeClones(cloned, addressCloned)
+	handle := C.uniffi_ant_ffi_fn_method_userdata_remove_file_archive(cloned, addressCloned, &status)
+
+	if err := checkStatus(&status, "UserData.RemoveFileArchive", liftVaultError); err != nil {
+		return nil, err
+	}
+
+	return newUserData(handle), nil
+}
+
+// RemovePrivateFileArchive removes a private archive reference and returns the updated user data.
+func (ud *UserData) RemovePrivateFileArchive(dataMap *PrivateArchiveDataMap) (*UserData, error) {
+	if dataMap == nil {
+		return nil, ErrNilPointer
+	}
+
+	ud.mu.Lock()
+	defer ud.mu.Unlock()
+
+	if ud.freed {
+		return nil, ErrDisposed
+	}
+
+	dataMapCloned := dataMap.CloneHandle()
+	if dataMapCloned == nil {
+		return nil, ErrDisposed
+	}
+
+	cloned := ud.cloneHandle()
+	var status C.RustCallStatus
+	consumeClones(cloned, dataMapCloned)
+	handle := C.uniffi_ant_ffi_fn_method_userdata_remove_private_file_archive(cloned, dataMapCloned, &status)
+
+	if err := checkStatus(&status, "UserData.RemovePrivateFileArchive", liftVaultError); err != nil {
+		return nil, err
+	}
+
+	return newUserData(handle), nil
+}
+
+// RenameFileArchive renames a public archive reference and returns the updated user data.
+// Fails if the archive is not referenced.
+func (ud *UserData) RenameFileArchive(address *ArchiveAddress, name string) (*UserData, error) {
+	if address == nil {
+		return nil, ErrNilPointer
+	}
+
+	ud.mu.Lock()
+	defer ud.mu.Unlock()
+
+	if ud.freed {
+		return nil, ErrDisposed
+	}
+
+	addressCloned := address.CloneHandle()
+	if addressCloned == nil {
+		return nil, ErrDisposed
+	}
+
+	cloned := ud.cloneHandle()
+	nameBuffer := stringToRustBuffer(name)
+	var status C.RustCallStatus
+	consumeClones(cloned, addressCloned)
+	handle := C.uniffi_ant_ffi_fn_method_userdata_rename_file_archive(cloned, addressCloned, nameBuffer, &status)
+
+	if err := checkStatus(&status, "UserData.RenameFileArchive", liftVaultError); err != nil {
+		return nil, err
+	}
+
+	return newUserData(handle), nil
+}
+
+// RenamePrivateFileArchive renames a private archive reference and returns the updated user data.
+// Fails if the archive is not referenced.
+func (ud *UserData) RenamePrivateFileArchive(dataMap *PrivateArchiveDataMap, name string) (*UserData, error) {
+	if dataMap == nil {
+		return nil, ErrNilPointer
+	}
+
+	ud.mu.Lock()
+	defer ud.mu.Unlock()
+
+	if ud.freed {
+		return nil, ErrDisposed
+	}
+
+	dataMapCloned := dataMap.CloneHandle()
+	if dataMapCloned == nil {
+		return nil, ErrDisposed
+	}
+
+	cloned := ud.cloneHandle()
+	nameBuffer := stringToRustBuffer(name)
+	var status C.RustCallStatus
+	consumeClones(cloned, dataMapCloned)
+	handle := C.uniffi_ant_ffi_fn_method_userdata_rename_private_file_archive(cloned, dataMapCloned, nameBuffer, &status)
+
+	if err := checkStatus(&status, "UserData.RenamePrivateFileArchive", liftVaultError); err != nil {
+		return nil, err
+	}
+
+	return newUserData(handle), nil
+}
+
 func (ud *UserData) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_userdata(ud.handle, &status)
+	return trackClone("UserData", C.uniffi_ant_ffi_fn_clone_userdata(ud.handle, &status))
 }
 
 func (ud *UserData) CloneHandle() unsafe.Pointer {
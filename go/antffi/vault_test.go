@@ -0,0 +1,69 @@
+package antffi
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+// buildStringPairs hand-builds the UniFFI encoding of a Vec of two-string records.
+func buildStringPairs(pairs ...[2]string) []byte {
+	buf := binary.BigEndian.AppendUint32(nil, uint32(len(pairs)))
+	for _, pair := range pairs {
+		for _, s := range pair {
+			buf = binary.BigEndian.AppendUint32(buf, uint32(len(s)))
+			buf = append(buf, s...)
+		}
+	}
+	return buf
+}
+
+func TestDecodeFileArchiveEntries(t *testing.T) {
+	entries, err := decodeFileArchiveEntries(buildStringPairs([2]string{"a1b2", "photos"}, [2]string{"c3d4", ""}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0] != (FileArchiveEntry{Address: "a1b2", Name: "photos"}) || entries[1] != (FileArchiveEntry{Address: "c3d4"}) {
+		t.Fatalf("Decoded %+v", entries)
+	}
+
+	entries, err = decodeFileArchiveEntries(buildStringPairs())
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("Empty listing decoded as %+v, %v", entries, err)
+	}
+}
+
+func TestDecodePrivateFileArchiveEntries(t *testing.T) {
+	entries, err := decodePrivateFileArchiveEntries(buildStringPairs([2]string{"deadbeef", "notes"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0] != (PrivateFileArchiveEntry{DataMap: "deadbeef", Name: "notes"}) {
+		t.Fatalf("Decoded %+v", entries)
+	}
+}
+
+func TestDecodeFileArchiveEntriesMalformed(t *testing.T) {
+	valid := buildStringPairs([2]string{"a1b2", "photos"})
+
+	cases := map[string][]byte{
+		"empty":           nil,
+		"negative count":  binary.BigEndian.AppendUint32(nil, 0xffffffff),
+		"count too large": append(binary.BigEndian.AppendUint32(nil, 1<<30), valid[4:]...),
+		"missing name":    valid[:12],
+		"truncated name":  valid[:len(valid)-1],
+		"missing entry":   append(binary.BigEndian.AppendUint32(nil, 2), valid[4:]...),
+		"trailing bytes":  append(append([]byte{}, valid...), 0),
+	}
+
+	for name, data := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := decodeFileArchiveEntries(data); !errors.Is(err, ErrMalformedResult) {
+				t.Fatalf("FileArchiveEntry: expected ErrMalformedResult, got %v", err)
+			}
+			if _, err := decodePrivateFileArchiveEntries(data); !errors.Is(err, ErrMalformedResult) {
+				t.Fatalf("PrivateFileArchiveEntry: expected ErrMalformedResult, got %v", err)
+			}
+		})
+	}
+}
@@ -33,7 +33,7 @@ import (
 // Wallet represents an EVM wallet for managing tokens and payments on the Autonomi network.
 type Wallet struct {
 	handle unsafe.Pointer
-	freed  bool
+	calls  callRefs
 	mu     sync.Mutex
 }
 
@@ -54,9 +54,10 @@ func NewWalletFromPrivateKey(network *Network, privateKey string) (*Wallet, erro
 
 	privateKeyBuffer := stringToRustBuffer(privateKey)
 	var status C.RustCallStatus
+	consumeClones(networkHandle)
 	handle := C.uniffi_ant_ffi_fn_constructor_wallet_new_from_private_key(networkHandle, privateKeyBuffer, &status)
 
-	if err := checkStatus(&status, "Wallet.FromPrivateKey"); err != nil {
+	if err := checkStatus(&status, "Wallet.FromPrivateKey", liftWalletError); err != nil {
 		return nil, err
 	}
 
@@ -66,21 +67,57 @@ func NewWalletFromPrivateKey(network *Network, privateKey string) (*Wallet, erro
 func newWallet(handle unsafe.Pointer) *Wallet {
 	w := &Wallet{handle: handle}
 	runtime.SetFinalizer(w, (*Wallet).Free)
+	trackHandle(unsafe.Pointer(w), "Wallet", w.handle)
 	return w
 }
 
 // Free releases the wallet resources.
+// Calls made after Free fail with ErrDisposed; a balance query already in
+// flight keeps the native handle alive until it returns.
 func (w *Wallet) Free() {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
-	if w.freed || w.handle == nil {
-		return
+	if w.calls.close() && w.handle != nil {
+		w.releaseHandle()
 	}
+}
+
+// Close implements io.Closer by calling Free. It always returns nil.
+func (w *Wallet) Close() error {
+	w.Free()
+	return nil
+}
 
+// releaseHandle frees the native handle. w.mu must be held.
+func (w *Wallet) releaseHandle() {
 	var status C.RustCallStatus
 	C.uniffi_ant_ffi_fn_free_wallet(w.handle, &status)
-	w.freed = true
+	untrackHandle(unsafe.Pointer(w))
+	w.handle = nil
+}
+
+// acquire starts an in-flight call and returns a cloned handle for it.
+// Every successful acquire must be paired with a release.
+func (w *Wallet) acquire() (unsafe.Pointer, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.handle == nil {
+		return nil, ErrDisposed
+	}
+	if err := w.calls.begin(); err != nil {
+		return nil, err
+	}
+	return w.cloneHandle(), nil
+}
+
+// release ends an in-flight call started by acquire.
+func (w *Wallet) release() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.calls.end() {
+		w.releaseHandle()
+	}
 }
 
 // Address returns the wallet's EVM address as a hex string.
@@ -88,15 +125,16 @@ func (w *Wallet) Address() (string, error) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
-	if w.freed {
+	if w.calls.closed || w.handle == nil {
 		return "", ErrDisposed
 	}
 
 	cloned := w.cloneHandle()
 	var status C.RustCallStatus
+	consumeClones(cloned)
 	result := C.uniffi_ant_ffi_fn_method_wallet_address(cloned, &status)
 
-	if err := checkStatus(&status, "Wallet.Address"); err != nil {
+	if err := checkStatus(&status, "Wallet.Address", liftWalletError); err != nil {
 		return "", err
 	}
 
@@ -105,16 +143,15 @@ func (w *Wallet) Address() (string, error) {
 
 // BalanceOfTokens returns the balance of tokens in the wallet.
 func (w *Wallet) BalanceOfTokens(ctx context.Context) (string, error) {
-	w.mu.Lock()
-	if w.freed {
-		w.mu.Unlock()
-		return "", ErrDisposed
+	cloned, err := w.acquire()
+	if err != nil {
+		return "", err
 	}
-	cloned := w.cloneHandle()
-	w.mu.Unlock()
+	defer w.release()
 
+	consumeClones(cloned)
 	futureHandle := uint64(C.uniffi_ant_ffi_fn_method_wallet_balance_of_tokens(cloned))
-	buf, err := pollRustBufferFuture(ctx, futureHandle)
+	_, buf, err := pollFuture(ctx, futureHandle, FutureTypeRustBuffer, liftWalletError)
 	if err != nil {
 		return "", err
 	}
@@ -124,14 +161,14 @@ func (w *Wallet) BalanceOfTokens(ctx context.Context) (string, error) {
 
 func (w *Wallet) cloneHandle() unsafe.Pointer {
 	var status C.RustCallStatus
-	return C.uniffi_ant_ffi_fn_clone_wallet(w.handle, &status)
+	return trackClone("Wallet", C.uniffi_ant_ffi_fn_clone_wallet(w.handle, &status))
 }
 
 // CloneHandle returns a cloned handle for FFI operations.
 func (w *Wallet) CloneHandle() unsafe.Pointer {
 	w.mu.Lock()
 	defer w.mu.Unlock()
-	if w.freed {
+	if w.calls.closed || w.handle == nil {
 		return nil
 	}
 	return w.cloneHandle()
@@ -4,7 +4,11 @@ package antffi_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -80,6 +84,32 @@ func TestClientInit(t *testing.T) {
 	defer client.Free()
 }
 
+func TestClientInitWithConfig(t *testing.T) {
+	peers := os.Getenv("ANT_PEERS")
+	if peers == "" {
+		t.Skip("ANT_PEERS not set, skipping explicit peers test")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	network, err := antffi.NewNetwork(true)
+	if err != nil {
+		t.Fatalf("NewNetwork failed: %v", err)
+	}
+	defer network.Free()
+
+	client, err := antffi.NewClientWithConfig(ctx, antffi.ClientConfig{
+		Peers:   strings.Split(peers, ","),
+		Network: network,
+		DataDir: t.TempDir(),
+	})
+	if err != nil {
+		t.Fatalf("NewClientWithConfig failed: %v", err)
+	}
+	defer client.Free()
+}
+
 func TestClientDataPublic(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 	defer cancel()
@@ -204,6 +234,59 @@ func TestClientDataCost(t *testing.T) {
 	t.Logf("Data cost: %s", cost)
 }
 
+func TestClientVaultRaw(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	client, err := antffi.NewClientLocal(ctx)
+	if err != nil {
+		t.Fatalf("NewClientLocal failed: %v", err)
+	}
+	defer client.Free()
+
+	network, err := antffi.NewNetwork(true)
+	if err != nil {
+		t.Fatalf("NewNetwork failed: %v", err)
+	}
+	defer network.Free()
+
+	wallet, err := antffi.NewWalletFromPrivateKey(network, TestPrivateKey)
+	if err != nil {
+		t.Fatalf("NewWalletFromPrivateKey failed: %v", err)
+	}
+	defer wallet.Free()
+
+	key, err := antffi.NewVaultSecretKey()
+	if err != nil {
+		t.Fatalf("NewVaultSecretKey failed: %v", err)
+	}
+	defer key.Free()
+
+	testData := []byte("Hello from Go vault test!")
+	const contentType uint64 = 42
+
+	payment := &antffi.PaymentOption{Wallet: wallet}
+
+	cost, err := client.VaultPut(ctx, key, payment, testData, contentType)
+	if err != nil {
+		t.Fatalf("VaultPut failed: %v", err)
+	}
+
+	t.Logf("Vault written (cost: %s)", cost)
+
+	result, err := client.VaultGet(ctx, key)
+	if err != nil {
+		t.Fatalf("VaultGet failed: %v", err)
+	}
+
+	if string(result.Data) != string(testData) {
+		t.Fatalf("Data mismatch: %s != %s", result.Data, testData)
+	}
+	if result.ContentType != contentType {
+		t.Fatalf("Content type mismatch: %d != %d", result.ContentType, contentType)
+	}
+}
+
 func TestWalletBalanceOfTokens(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -289,10 +372,11 @@ func TestClientPointerOperations(t *testing.T) {
 
 	// Put the pointer
 	payment := &antffi.PaymentOption{Wallet: wallet}
-	err = client.PointerPut(ctx, pointer, payment)
+	putAddr, err := client.PointerPut(ctx, pointer, payment)
 	if err != nil {
 		t.Fatalf("PointerPut failed: %v", err)
 	}
+	defer putAddr.Free()
 
 	// Get the pointer address
 	pointerAddr, err := pointer.Address()
@@ -319,6 +403,359 @@ func TestClientPointerOperations(t *testing.T) {
 	}
 }
 
+func TestClientPointerUpdateFrom(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 90*time.Second)
+	defer cancel()
+
+	client, err := antffi.NewClientLocal(ctx)
+	if err != nil {
+		t.Fatalf("NewClientLocal failed: %v", err)
+	}
+	defer client.Free()
+
+	network, err := antffi.NewNetwork(true)
+	if err != nil {
+		t.Fatalf("NewNetwork failed: %v", err)
+	}
+	defer network.Free()
+
+	wallet, err := antffi.NewWalletFromPrivateKey(network, TestPrivateKey)
+	if err != nil {
+		t.Fatalf("NewWalletFromPrivateKey failed: %v", err)
+	}
+	defer wallet.Free()
+
+	sk, err := antffi.NewSecretKey()
+	if err != nil {
+		t.Fatalf("NewSecretKey failed: %v", err)
+	}
+	defer sk.Free()
+
+	targets := make([]*antffi.PointerTarget, 2)
+	for i := range targets {
+		address, err := antffi.ChunkAddressFromContent([]byte(fmt.Sprintf("target %d", i)))
+		if err != nil {
+			t.Fatalf("ChunkAddressFromContent failed: %v", err)
+		}
+		defer address.Free()
+		targets[i], err = antffi.NewPointerTargetChunk(address)
+		if err != nil {
+			t.Fatalf("NewPointerTargetChunk failed: %v", err)
+		}
+		defer targets[i].Free()
+	}
+
+	pointer, err := antffi.NewNetworkPointer(sk, 0, targets[0])
+	if err != nil {
+		t.Fatalf("NewNetworkPointer failed: %v", err)
+	}
+	defer pointer.Free()
+
+	putAddr, err := client.PointerPut(ctx, pointer, &antffi.PaymentOption{Wallet: wallet})
+	if err != nil {
+		t.Fatalf("PointerPut failed: %v", err)
+	}
+	defer putAddr.Free()
+
+	updated, err := client.PointerUpdateFrom(ctx, pointer, sk, targets[1])
+	if err != nil {
+		t.Fatalf("PointerUpdateFrom failed: %v", err)
+	}
+	defer updated.Free()
+
+	retrieved, err := client.PointerGet(ctx, putAddr)
+	if err != nil {
+		t.Fatalf("PointerGet failed: %v", err)
+	}
+	defer retrieved.Free()
+
+	for name, p := range map[string]*antffi.NetworkPointer{"returned": updated, "stored": retrieved} {
+		counter, err := p.Counter()
+		if err != nil {
+			t.Fatalf("Counter failed: %v", err)
+		}
+		if counter != 1 {
+			t.Fatalf("The %s pointer has counter %d, want 1", name, counter)
+		}
+	}
+}
+
+func TestClientScratchpadUpdateFrom(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 90*time.Second)
+	defer cancel()
+
+	client, err := antffi.NewClientLocal(ctx)
+	if err != nil {
+		t.Fatalf("NewClientLocal failed: %v", err)
+	}
+	defer client.Free()
+
+	network, err := antffi.NewNetwork(true)
+	if err != nil {
+		t.Fatalf("NewNetwork failed: %v", err)
+	}
+	defer network.Free()
+
+	wallet, err := antffi.NewWalletFromPrivateKey(network, TestPrivateKey)
+	if err != nil {
+		t.Fatalf("NewWalletFromPrivateKey failed: %v", err)
+	}
+	defer wallet.Free()
+
+	sk, err := antffi.NewSecretKey()
+	if err != nil {
+		t.Fatalf("NewSecretKey failed: %v", err)
+	}
+	defer sk.Free()
+
+	const contentType uint64 = 7
+	created, err := client.ScratchpadCreate(ctx, sk, contentType, []byte("first"), &antffi.PaymentOption{Wallet: wallet})
+	if err != nil {
+		t.Fatalf("ScratchpadCreate failed: %v", err)
+	}
+	defer created.Address.Free()
+
+	current, err := client.ScratchpadGet(ctx, created.Address)
+	if err != nil {
+		t.Fatalf("ScratchpadGet failed: %v", err)
+	}
+	defer current.Free()
+
+	updated, err := client.ScratchpadUpdateFrom(ctx, current, sk, contentType, []byte("second"))
+	if err != nil {
+		t.Fatalf("ScratchpadUpdateFrom failed: %v", err)
+	}
+	defer updated.Free()
+
+	counter, err := updated.Counter()
+	if err != nil {
+		t.Fatalf("Counter failed: %v", err)
+	}
+	next, err := antffi.NewScratchpad(sk, contentType, []byte("third"), counter+1)
+	if err != nil {
+		t.Fatalf("NewScratchpad failed: %v", err)
+	}
+	defer next.Free()
+
+	if err := client.ScratchpadPutUpdate(ctx, next); err != nil {
+		t.Fatalf("ScratchpadPutUpdate failed: %v", err)
+	}
+
+	stored, err := client.ScratchpadGet(ctx, created.Address)
+	if err != nil {
+		t.Fatalf("ScratchpadGet failed: %v", err)
+	}
+	defer stored.Free()
+
+	data, err := stored.DecryptData(sk)
+	if err != nil {
+		t.Fatalf("DecryptData failed: %v", err)
+	}
+	if string(data) != "third" {
+		t.Fatalf("Stored scratchpad holds %q, want %q", data, "third")
+	}
+}
+
+// TestClientUpdateFromDisposedArguments passes freed arguments to an open
+// client, so they are detected after the client handle was acquired.
+func TestClientUpdateFromDisposedArguments(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	client, err := antffi.NewClientLocal(ctx)
+	if err != nil {
+		t.Fatalf("NewClientLocal failed: %v", err)
+	}
+	defer client.Free()
+
+	owner, err := antffi.NewSecretKey()
+	if err != nil {
+		t.Fatalf("NewSecretKey failed: %v", err)
+	}
+	defer owner.Free()
+	address, err := antffi.ChunkAddressFromContent([]byte("target"))
+	if err != nil {
+		t.Fatalf("ChunkAddressFromContent failed: %v", err)
+	}
+	defer address.Free()
+	target, err := antffi.NewPointerTargetChunk(address)
+	if err != nil {
+		t.Fatalf("NewPointerTargetChunk failed: %v", err)
+	}
+	defer target.Free()
+	pointer, err := antffi.NewNetworkPointer(owner, 0, target)
+	if err != nil {
+		t.Fatalf("NewNetworkPointer failed: %v", err)
+	}
+	defer pointer.Free()
+	scratchpad, err := antffi.NewScratchpad(owner, 0, []byte("data"), 0)
+	if err != nil {
+		t.Fatalf("NewScratchpad failed: %v", err)
+	}
+	defer scratchpad.Free()
+
+	freed := func(t *testing.T) (*antffi.SecretKey, *antffi.PointerTarget, *antffi.NetworkPointer, *antffi.Scratchpad) {
+		key, err := antffi.NewSecretKey()
+		if err != nil {
+			t.Fatalf("NewSecretKey failed: %v", err)
+		}
+		key.Free()
+		freedTarget, err := antffi.NewPointerTargetChunk(address)
+		if err != nil {
+			t.Fatalf("NewPointerTargetChunk failed: %v", err)
+		}
+		freedTarget.Free()
+		freedPointer, err := antffi.NewNetworkPointer(owner, 0, target)
+		if err != nil {
+			t.Fatalf("NewNetworkPointer failed: %v", err)
+		}
+		freedPointer.Free()
+		freedScratchpad, err := antffi.NewScratchpad(owner, 0, []byte("data"), 0)
+		if err != nil {
+			t.Fatalf("NewScratchpad failed: %v", err)
+		}
+		freedScratchpad.Free()
+		return key, freedTarget, freedPointer, freedScratchpad
+	}
+	freedKey, freedTarget, freedPointer, freedScratchpad := freed(t)
+
+	cases := map[string]func() error{
+		"PointerUpdateFrom current": func() error {
+			_, err := client.PointerUpdateFrom(ctx, freedPointer, owner, target)
+			return err
+		},
+		"PointerUpdateFrom owner": func() error {
+			_, err := client.PointerUpdateFrom(ctx, pointer, freedKey, target)
+			return err
+		},
+		"PointerUpdateFrom target": func() error {
+			_, err := client.PointerUpdateFrom(ctx, pointer, owner, freedTarget)
+			return err
+		},
+		"ScratchpadUpdateFrom current": func() error {
+			_, err := client.ScratchpadUpdateFrom(ctx, freedScratchpad, owner, 0, []byte("data"))
+			return err
+		},
+		"ScratchpadUpdateFrom owner": func() error {
+			_, err := client.ScratchpadUpdateFrom(ctx, scratchpad, freedKey, 0, []byte("data"))
+			return err
+		},
+		"ScratchpadPutUpdate": func() error {
+			return client.ScratchpadPutUpdate(ctx, freedScratchpad)
+		},
+		"PointerPut": func() error {
+			_, err := client.PointerPut(ctx, freedPointer, nil)
+			return err
+		},
+	}
+	for name, call := range cases {
+		if err := call(); !errors.Is(err, antffi.ErrDisposed) {
+			t.Errorf("%s: expected ErrDisposed, got %v", name, err)
+		}
+	}
+
+	// The rejected calls released the client, which is still usable.
+	if _, err := client.DataCost(ctx, []byte("still open")); err != nil {
+		t.Fatalf("DataCost after the rejected calls failed: %v", err)
+	}
+}
+
+// TestClientCloseDuringCall closes a client while a download holds its handle.
+// The download runs to completion; only calls made after Close are rejected.
+func TestClientCloseDuringCall(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	defer cancel()
+
+	client, err := antffi.NewClientLocal(ctx)
+	if err != nil {
+		t.Fatalf("NewClientLocal failed: %v", err)
+	}
+	defer client.Free()
+
+	network, err := antffi.NewNetwork(true)
+	if err != nil {
+		t.Fatalf("NewNetwork failed: %v", err)
+	}
+	defer network.Free()
+
+	wallet, err := antffi.NewWalletFromPrivateKey(network, TestPrivateKey)
+	if err != nil {
+		t.Fatalf("NewWalletFromPrivateKey failed: %v", err)
+	}
+	defer wallet.Free()
+
+	// Several chunks, so the download is still running when Close is called.
+	testData := make([]byte, 8*1024*1024)
+	for i := range testData {
+		testData[i] = byte(i % 251)
+	}
+	result, err := client.DataPutPublic(ctx, testData, &antffi.PaymentOption{Wallet: wallet})
+	if err != nil {
+		t.Fatalf("DataPutPublic failed: %v", err)
+	}
+
+	download := client.StartDataGetPublic(ctx, result.Address)
+	time.Sleep(50 * time.Millisecond)
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close returned %v", err)
+	}
+
+	if _, err := client.DataCost(ctx, []byte("after close")); !errors.Is(err, antffi.ErrDisposed) {
+		t.Fatalf("Expected ErrDisposed for a call after Close, got %v", err)
+	}
+	if client.CloneHandle() != nil {
+		t.Fatal("CloneHandle returned a handle after Close")
+	}
+
+	downloaded, err := download.Await(ctx)
+	if errors.Is(err, antffi.ErrDisposed) {
+		t.Skip("The download had not started when Close was called")
+	}
+	if err != nil {
+		t.Fatalf("Download in flight during Close failed: %v", err)
+	}
+	if string(downloaded) != string(testData) {
+		t.Fatal("Download in flight during Close returned different data")
+	}
+}
+
+// TestClientCloseRaceWithCalls races calls against Close. Each call either
+// completes or is rejected with ErrDisposed; none runs on a released handle.
+func TestClientCloseRaceWithCalls(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	client, err := antffi.NewClientLocal(ctx)
+	if err != nil {
+		t.Fatalf("NewClientLocal failed: %v", err)
+	}
+	defer client.Free()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 5; j++ {
+				_, err := client.DataCost(ctx, []byte(fmt.Sprintf("race %d/%d", i, j)))
+				if errors.Is(err, antffi.ErrDisposed) {
+					return
+				}
+				if err != nil {
+					t.Errorf("DataCost failed: %v", err)
+					return
+				}
+			}
+		}(i)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close returned %v", err)
+	}
+	wg.Wait()
+}
+
 func TestContextCancellation(t *testing.T) {
 	// Create a context that's already cancelled
 	ctx, cancel := context.WithCancel(context.Background())
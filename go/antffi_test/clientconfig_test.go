@@ -0,0 +1,132 @@
+package antffi_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/maidsafe/ant-ffi/go/antffi"
+)
+
+func TestClientConfigInvalidPeers(t *testing.T) {
+	invalid := []string{
+		"",
+		"ip4/127.0.0.1/tcp/12000",
+		"/ip4/127.0.0.1/tcp",
+		"/ip4/256.0.0.1/tcp/12000",
+		"/ip4/127.0.0.1/tcp/70000",
+		"/ip6/127.0.0.1/udp/12000/quic-v1",
+		"/ip4/127.0.0.1/tcp/12000/p2p/not-a-peer-id!",
+		"/unknown/127.0.0.1",
+	}
+
+	for _, peer := range invalid {
+		_, err := antffi.NewClientWithConfig(context.Background(), antffi.ClientConfig{
+			Peers: []string{"/ip4/127.0.0.1/tcp/12000", peer},
+		})
+		if err == nil {
+			t.Fatalf("Expected error for peer %q", peer)
+		}
+
+		var peerErr *antffi.PeerError
+		if !errors.As(err, &peerErr) {
+			t.Fatalf("Expected *PeerError for peer %q, got %T: %v", peer, err, err)
+		}
+		if peerErr.Peer != peer {
+			t.Fatalf("PeerError.Peer mismatch: %q != %q", peerErr.Peer, peer)
+		}
+		if !errors.Is(err, antffi.ErrInvalidPeer) {
+			t.Fatalf("Expected ErrInvalidPeer for peer %q", peer)
+		}
+
+		t.Logf("Rejected %q: %v", peer, err)
+	}
+}
+
+func TestClientConfigNoPeers(t *testing.T) {
+	_, err := antffi.NewClientWithConfig(context.Background(), antffi.ClientConfig{})
+	if !errors.Is(err, antffi.ErrInvalidArgument) {
+		t.Fatalf("Expected ErrInvalidArgument, got %v", err)
+	}
+
+	var peerErr *antffi.PeerError
+	if errors.As(err, &peerErr) {
+		t.Fatalf("Expected no *PeerError without peers, got %v", err)
+	}
+}
+
+func TestClientConfigNilNetwork(t *testing.T) {
+	_, err := antffi.NewClientWithConfig(context.Background(), antffi.ClientConfig{
+		Peers: []string{
+			"/ip4/10.0.2.2/tcp/12000",
+			"/ip6/::1/udp/12001/quic-v1/p2p/12D3KooWRBhwfeP2Y4TCx1SM6s9rUoHhR5STiGwxBhgFRcw3UERE",
+			"/dns4/bootstrap.example.com/tcp/443/wss",
+		},
+	})
+	if !errors.Is(err, antffi.ErrNilPointer) {
+		t.Fatalf("Expected ErrNilPointer, got %v", err)
+	}
+}
+
+// standInPeer listens on a local UDP port in place of a bootstrap node and
+// reports on dialed once a client sends it a packet. It never completes a
+// handshake, so bootstrapping from it alone cannot succeed.
+func standInPeer(t *testing.T) (addr string, dialed <-chan struct{}) {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listening for the stand-in peer failed: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	ch := make(chan struct{})
+	go func() {
+		buf := make([]byte, 2048)
+		if _, _, err := conn.ReadFrom(buf); err == nil {
+			close(ch)
+		}
+	}()
+
+	port := conn.LocalAddr().(*net.UDPAddr).Port
+	peerID := "12D3KooWRBhwfeP2Y4TCx1SM6s9rUoHhR5STiGwxBhgFRcw3UERE"
+	return fmt.Sprintf("/ip4/127.0.0.1/udp/%d/quic-v1/p2p/%s", port, peerID), ch
+}
+
+func TestClientConfigStandInPeer(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	network, err := antffi.NewNetwork(true)
+	if err != nil {
+		t.Fatalf("NewNetwork failed: %v", err)
+	}
+	defer network.Free()
+
+	peer, dialed := standInPeer(t)
+	client, err := antffi.NewClientWithConfig(ctx, antffi.ClientConfig{
+		Peers:   []string{peer},
+		Network: network,
+		DataDir: t.TempDir(),
+	})
+	if err == nil {
+		client.Free()
+		t.Fatal("Expected bootstrapping from a peer that never answers to fail")
+	}
+
+	// The peer passed validation, so the failure comes from bootstrapping.
+	var peerErr *antffi.PeerError
+	if errors.As(err, &peerErr) {
+		t.Fatalf("Expected a bootstrap failure, got %v", err)
+	}
+
+	select {
+	case <-dialed:
+	default:
+		t.Fatalf("The client never dialed the configured peer %s (error: %v)", peer, err)
+	}
+	t.Logf("Bootstrap failed as expected: %v", err)
+}
@@ -1,6 +1,9 @@
 package antffi_test
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/maidsafe/ant-ffi/go/antffi"
@@ -313,6 +316,251 @@ func TestPrivateArchive(t *testing.T) {
 	}
 }
 
+func TestPublicArchiveFiles(t *testing.T) {
+	archive, err := antffi.NewPublicArchive()
+	if err != nil {
+		t.Fatalf("NewPublicArchive failed: %v", err)
+	}
+	defer archive.Free()
+
+	chunk, err := antffi.NewChunk([]byte("Test data for archive entry"))
+	if err != nil {
+		t.Fatalf("NewChunk failed: %v", err)
+	}
+	defer chunk.Free()
+
+	chunkAddr, err := chunk.Address()
+	if err != nil {
+		t.Fatalf("Address failed: %v", err)
+	}
+	defer chunkAddr.Free()
+
+	addrHex, err := chunkAddr.ToHex()
+	if err != nil {
+		t.Fatalf("ToHex failed: %v", err)
+	}
+
+	dataAddr, err := antffi.DataAddressFromHex(addrHex)
+	if err != nil {
+		t.Fatalf("DataAddressFromHex failed: %v", err)
+	}
+	defer dataAddr.Free()
+
+	meta, err := antffi.NewMetadataWithTimestamps(27, 1000000, 2000000)
+	if err != nil {
+		t.Fatalf("NewMetadataWithTimestamps failed: %v", err)
+	}
+	defer meta.Free()
+
+	updated, err := archive.AddFile("docs/readme.txt", dataAddr, meta)
+	if err != nil {
+		t.Fatalf("AddFile failed: %v", err)
+	}
+	defer updated.Free()
+
+	files, err := updated.Files()
+	if err != nil {
+		t.Fatalf("Files failed: %v", err)
+	}
+	defer func() {
+		for i := range files {
+			files[i].Free()
+		}
+	}()
+
+	if len(files) != 1 {
+		t.Fatalf("Expected 1 file, got %d", len(files))
+	}
+
+	entry := files[0]
+	if entry.Path != "docs/readme.txt" {
+		t.Fatalf("Path mismatch: %s != docs/readme.txt", entry.Path)
+	}
+
+	entryHex, err := entry.Address.ToHex()
+	if err != nil {
+		t.Fatalf("ToHex failed: %v", err)
+	}
+	if entryHex != addrHex {
+		t.Fatalf("Address mismatch: %s != %s", entryHex, addrHex)
+	}
+
+	size, err := entry.Metadata.Size()
+	if err != nil {
+		t.Fatalf("Size failed: %v", err)
+	}
+	if size != 27 {
+		t.Fatalf("Size mismatch: %d != 27", size)
+	}
+
+	modified, err := entry.Metadata.Modified()
+	if err != nil {
+		t.Fatalf("Modified failed: %v", err)
+	}
+	if modified != 2000000 {
+		t.Fatalf("Modified mismatch: %d != 2000000", modified)
+	}
+}
+
+func TestPrivateArchiveFiles(t *testing.T) {
+	archive, err := antffi.NewPrivateArchive()
+	if err != nil {
+		t.Fatalf("NewPrivateArchive failed: %v", err)
+	}
+	defer archive.Free()
+
+	paths := []string{"a.txt", "nested/b.txt"}
+	for i, path := range paths {
+		dataMap, err := antffi.DataMapChunkFromHex(fmt.Sprintf("deadbeef%02x", i))
+		if err != nil {
+			t.Fatalf("DataMapChunkFromHex failed: %v", err)
+		}
+		defer dataMap.Free()
+
+		meta, err := antffi.NewMetadata(uint64(100 + i))
+		if err != nil {
+			t.Fatalf("NewMetadata failed: %v", err)
+		}
+		defer meta.Free()
+
+		next, err := archive.AddFile(path, dataMap, meta)
+		if err != nil {
+			t.Fatalf("AddFile failed: %v", err)
+		}
+		defer next.Free()
+		archive = next
+	}
+
+	files, err := archive.Files()
+	if err != nil {
+		t.Fatalf("Files failed: %v", err)
+	}
+	defer func() {
+		for i := range files {
+			files[i].Free()
+		}
+	}()
+
+	if len(files) != len(paths) {
+		t.Fatalf("Expected %d files, got %d", len(paths), len(files))
+	}
+
+	found := make(map[string]uint64)
+	for _, entry := range files {
+		if _, err := entry.DataMap.ToHex(); err != nil {
+			t.Fatalf("DataMap.ToHex failed: %v", err)
+		}
+		size, err := entry.Metadata.Size()
+		if err != nil {
+			t.Fatalf("Size failed: %v", err)
+		}
+		found[entry.Path] = size
+	}
+
+	for i, path := range paths {
+		size, ok := found[path]
+		if !ok {
+			t.Fatalf("Missing file %s", path)
+		}
+		if size != uint64(100+i) {
+			t.Fatalf("Size mismatch for %s: %d != %d", path, size, 100+i)
+		}
+	}
+}
+
+// The archive DirContentUpload returns is a PrivateArchive like any other:
+// adding a file returns a new archive and leaves the uploaded one as it was.
+func TestPrivateArchiveAddFileKeepsOriginal(t *testing.T) {
+	archive, err := antffi.NewPrivateArchive()
+	if err != nil {
+		t.Fatalf("NewPrivateArchive failed: %v", err)
+	}
+	defer archive.Free()
+
+	dataMap, err := antffi.DataMapChunkFromHex("deadbeef")
+	if err != nil {
+		t.Fatalf("DataMapChunkFromHex failed: %v", err)
+	}
+	defer dataMap.Free()
+	meta, err := antffi.NewMetadata(4)
+	if err != nil {
+		t.Fatalf("NewMetadata failed: %v", err)
+	}
+	defer meta.Free()
+
+	uploaded, err := archive.AddFile("uploaded.txt", dataMap, meta)
+	if err != nil {
+		t.Fatalf("AddFile failed: %v", err)
+	}
+	defer uploaded.Free()
+
+	edited, err := uploaded.AddFile("added.txt", dataMap, meta)
+	if err != nil {
+		t.Fatalf("AddFile failed: %v", err)
+	}
+	defer edited.Free()
+
+	if count, err := edited.FileCount(); err != nil || count != 2 {
+		t.Fatalf("Edited archive has %d files, %v", count, err)
+	}
+	if count, err := uploaded.FileCount(); err != nil || count != 1 {
+		t.Fatalf("Uploaded archive has %d files, %v", count, err)
+	}
+
+	files, err := uploaded.Files()
+	if err != nil {
+		t.Fatalf("Files failed: %v", err)
+	}
+	for i := range files {
+		defer files[i].Free()
+	}
+	if len(files) != 1 || files[0].Path != "uploaded.txt" {
+		t.Fatalf("Files = %+v", files)
+	}
+}
+
+// A private archive data map and the DataMapChunk the private archive client
+// methods take share one hex encoding, which ArchiveGet and ArchivePut convert through.
+func TestPrivateArchiveDataMapHexRoundTrip(t *testing.T) {
+	dataMap, err := antffi.PrivateArchiveDataMapFromHex("deadbeef")
+	if err != nil {
+		t.Fatalf("PrivateArchiveDataMapFromHex failed: %v", err)
+	}
+	defer dataMap.Free()
+
+	hex, err := dataMap.ToHex()
+	if err != nil {
+		t.Fatalf("ToHex failed: %v", err)
+	}
+	chunk, err := antffi.DataMapChunkFromHex(hex)
+	if err != nil {
+		t.Fatalf("DataMapChunkFromHex failed: %v", err)
+	}
+	defer chunk.Free()
+
+	hex, err = chunk.ToHex()
+	if err != nil {
+		t.Fatalf("ToHex failed: %v", err)
+	}
+	if hex != "deadbeef" {
+		t.Fatalf("DataMapChunk hex mismatch: %q != %q", hex, "deadbeef")
+	}
+
+	back, err := antffi.PrivateArchiveDataMapFromHex(hex)
+	if err != nil {
+		t.Fatalf("PrivateArchiveDataMapFromHex failed: %v", err)
+	}
+	defer back.Free()
+
+	hex, err = back.ToHex()
+	if err != nil {
+		t.Fatalf("ToHex failed: %v", err)
+	}
+	if hex != "deadbeef" {
+		t.Fatalf("PrivateArchiveDataMap hex mismatch: %q != %q", hex, "deadbeef")
+	}
+}
+
 func TestVaultSecretKey(t *testing.T) {
 	// Create a random vault secret key
 	vsk, err := antffi.NewVaultSecretKey()
@@ -363,3 +611,463 @@ func TestUserData(t *testing.T) {
 		t.Fatal("NewUserData returned nil")
 	}
 }
+
+func TestUserDataArchives(t *testing.T) {
+	ud, err := antffi.NewUserData()
+	if err != nil {
+		t.Fatalf("NewUserData failed: %v", err)
+	}
+	defer ud.Free()
+
+	// Derive a valid archive address from a chunk address
+	chunk, err := antffi.NewChunk([]byte("Test data for user data archive"))
+	if err != nil {
+		t.Fatalf("NewChunk failed: %v", err)
+	}
+	defer chunk.Free()
+
+	chunkAddr, err := chunk.Address()
+	if err != nil {
+		t.Fatalf("Address failed: %v", err)
+	}
+	defer chunkAddr.Free()
+
+	addrHex, err := chunkAddr.ToHex()
+	if err != nil {
+		t.Fatalf("ToHex failed: %v", err)
+	}
+
+	archiveAddr, err := antffi.ArchiveAddressFromHex(addrHex)
+	if err != nil {
+		t.Fatalf("ArchiveAddressFromHex failed: %v", err)
+	}
+	defer archiveAddr.Free()
+
+	dataMap, err := antffi.PrivateArchiveDataMapFromHex("deadbeef")
+	if err != nil {
+		t.Fatalf("PrivateArchiveDataMapFromHex failed: %v", err)
+	}
+	defer dataMap.Free()
+
+	// Add one public and one private archive
+	ud2, err := ud.AddFileArchive(archiveAddr, "photos")
+	if err != nil {
+		t.Fatalf("AddFileArchive failed: %v", err)
+	}
+	defer ud2.Free()
+
+	ud3, err := ud2.AddPrivateFileArchive(dataMap, "documents")
+	if err != nil {
+		t.Fatalf("AddPrivateFileArchive failed: %v", err)
+	}
+	defer ud3.Free()
+
+	public, err := ud3.FileArchives()
+	if err != nil {
+		t.Fatalf("FileArchives failed: %v", err)
+	}
+	if len(public) != 1 || public[0].Name != "photos" || public[0].Address != addrHex {
+		t.Fatalf("Unexpected public archives: %+v", public)
+	}
+
+	private, err := ud3.PrivateFileArchives()
+	if err != nil {
+		t.Fatalf("PrivateFileArchives failed: %v", err)
+	}
+	if len(private) != 1 || private[0].Name != "documents" {
+		t.Fatalf("Unexpected private archives: %+v", private)
+	}
+
+	// The original user data is unchanged
+	original, err := ud.FileArchives()
+	if err != nil {
+		t.Fatalf("FileArchives failed: %v", err)
+	}
+	if len(original) != 0 {
+		t.Fatalf("Original user data should be empty, got %d archives", len(original))
+	}
+
+	// Rename both archives
+	ud4, err := ud3.RenameFileArchive(archiveAddr, "holiday photos")
+	if err != nil {
+		t.Fatalf("RenameFileArchive failed: %v", err)
+	}
+	defer ud4.Free()
+
+	ud5, err := ud4.RenamePrivateFileArchive(dataMap, "tax documents")
+	if err != nil {
+		t.Fatalf("RenamePrivateFileArchive failed: %v", err)
+	}
+	defer ud5.Free()
+
+	public, err = ud5.FileArchives()
+	if err != nil {
+		t.Fatalf("FileArchives failed: %v", err)
+	}
+	if len(public) != 1 || public[0].Name != "holiday photos" {
+		t.Fatalf("Unexpected public archives after rename: %+v", public)
+	}
+
+	private, err = ud5.PrivateFileArchives()
+	if err != nil {
+		t.Fatalf("PrivateFileArchives failed: %v", err)
+	}
+	if len(private) != 1 || private[0].Name != "tax documents" {
+		t.Fatalf("Unexpected private archives after rename: %+v", private)
+	}
+
+	// Renaming an unknown archive fails
+	if _, err := ud.RenameFileArchive(archiveAddr, "missing"); err == nil {
+		t.Fatal("RenameFileArchive should fail for an unknown archive")
+	}
+
+	// Remove both archives
+	ud6, err := ud5.RemoveFileArchive(archiveAddr)
+	if err != nil {
+		t.Fatalf("RemoveFileArchive failed: %v", err)
+	}
+	defer ud6.Free()
+
+	ud7, err := ud6.RemovePrivateFileArchive(dataMap)
+	if err != nil {
+		t.Fatalf("RemovePrivateFileArchive failed: %v", err)
+	}
+	defer ud7.Free()
+
+	public, err = ud7.FileArchives()
+	if err != nil {
+		t.Fatalf("FileArchives failed: %v", err)
+	}
+	private, err = ud7.PrivateFileArchives()
+	if err != nil {
+		t.Fatalf("PrivateFileArchives failed: %v", err)
+	}
+	if len(public) != 0 || len(private) != 0 {
+		t.Fatalf("Expected no archives after removal, got %d public and %d private", len(public), len(private))
+	}
+}
+
+func TestGraphEntryRoundTrip(t *testing.T) {
+	owner, err := antffi.NewSecretKey()
+	if err != nil {
+		t.Fatalf("NewSecretKey failed: %v", err)
+	}
+	defer owner.Free()
+
+	// Generate two parents and one descendant
+	var keyHexes []string
+	var publicKeys []*antffi.PublicKey
+	for i := 0; i < 3; i++ {
+		sk, err := antffi.NewSecretKey()
+		if err != nil {
+			t.Fatalf("NewSecretKey failed: %v", err)
+		}
+		defer sk.Free()
+
+		pk, err := sk.PublicKey()
+		if err != nil {
+			t.Fatalf("PublicKey failed: %v", err)
+		}
+		defer pk.Free()
+
+		hex, err := pk.ToHex()
+		if err != nil {
+			t.Fatalf("ToHex failed: %v", err)
+		}
+		keyHexes = append(keyHexes, hex)
+		publicKeys = append(publicKeys, pk)
+	}
+
+	var content [antffi.GraphContentSize]byte
+	copy(content[:], "graph entry content")
+
+	var descendantContent [antffi.GraphContentSize]byte
+	copy(descendantContent[:], "descendant content")
+
+	parents := publicKeys[:2]
+	descendants := []antffi.GraphDescendant{
+		{PublicKey: publicKeys[2], Content: descendantContent},
+	}
+
+	entry, err := antffi.NewGraphEntry(owner, parents, content, descendants)
+	if err != nil {
+		t.Fatalf("NewGraphEntry failed: %v", err)
+	}
+	defer entry.Free()
+
+	gotContent, err := entry.Content()
+	if err != nil {
+		t.Fatalf("Content failed: %v", err)
+	}
+	if string(gotContent) != string(content[:]) {
+		t.Fatalf("Content mismatch: %x != %x", gotContent, content)
+	}
+
+	gotParents, err := entry.Parents()
+	if err != nil {
+		t.Fatalf("Parents failed: %v", err)
+	}
+	if len(gotParents) != 2 {
+		t.Fatalf("Expected 2 parents, got %d", len(gotParents))
+	}
+	for i, parent := range gotParents {
+		defer parent.Free()
+		hex, err := parent.ToHex()
+		if err != nil {
+			t.Fatalf("ToHex failed: %v", err)
+		}
+		if hex != keyHexes[i] {
+			t.Fatalf("Parent %d mismatch: %s != %s", i, hex, keyHexes[i])
+		}
+	}
+
+	gotDescendants, err := entry.Descendants()
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if len(gotDescendants) != 1 {
+		t.Fatalf("Expected 1 descendant, got %d", len(gotDescendants))
+	}
+	defer gotDescendants[0].PublicKey.Free()
+
+	hex, err := gotDescendants[0].PublicKey.ToHex()
+	if err != nil {
+		t.Fatalf("ToHex failed: %v", err)
+	}
+	if hex != keyHexes[2] {
+		t.Fatalf("Descendant key mismatch: %s != %s", hex, keyHexes[2])
+	}
+	if gotDescendants[0].Content != descendantContent {
+		t.Fatalf("Descendant content mismatch: %x != %x", gotDescendants[0].Content, descendantContent)
+	}
+}
+
+func TestGraphEntryNoParents(t *testing.T) {
+	owner, err := antffi.NewSecretKey()
+	if err != nil {
+		t.Fatalf("NewSecretKey failed: %v", err)
+	}
+	defer owner.Free()
+
+	var content [antffi.GraphContentSize]byte
+	entry, err := antffi.NewGraphEntry(owner, nil, content, nil)
+	if err != nil {
+		t.Fatalf("NewGraphEntry failed: %v", err)
+	}
+	defer entry.Free()
+
+	parents, err := entry.Parents()
+	if err != nil {
+		t.Fatalf("Parents failed: %v", err)
+	}
+	descendants, err := entry.Descendants()
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if len(parents) != 0 || len(descendants) != 0 {
+		t.Fatalf("Expected no parents or descendants, got %d and %d", len(parents), len(descendants))
+	}
+}
+
+func TestGraphEntryInvalidArguments(t *testing.T) {
+	owner, err := antffi.NewSecretKey()
+	if err != nil {
+		t.Fatalf("NewSecretKey failed: %v", err)
+	}
+	defer owner.Free()
+
+	var content [antffi.GraphContentSize]byte
+
+	if _, err := antffi.NewGraphEntry(owner, []*antffi.PublicKey{nil}, content, nil); !errors.Is(err, antffi.ErrInvalidArgument) {
+		t.Fatalf("Expected ErrInvalidArgument for nil parent, got %v", err)
+	}
+
+	descendants := []antffi.GraphDescendant{{PublicKey: nil}}
+	if _, err := antffi.NewGraphEntry(owner, nil, content, descendants); !errors.Is(err, antffi.ErrInvalidArgument) {
+		t.Fatalf("Expected ErrInvalidArgument for nil descendant key, got %v", err)
+	}
+}
+
+// spliceFirstDifference returns a copy of original with the first byte that
+// differs from other replaced by other's byte. Used to re-encode a signed
+// record with one signed field changed while keeping the original signature.
+func spliceFirstDifference(t *testing.T, original, other []byte) []byte {
+	t.Helper()
+
+	tampered := append([]byte(nil), original...)
+	for i := range tampered {
+		if i < len(other) && tampered[i] != other[i] {
+			tampered[i] = other[i]
+			return tampered
+		}
+	}
+	t.Fatal("Encodings do not differ")
+	return nil
+}
+
+func TestNetworkPointerVerify(t *testing.T) {
+	sk, err := antffi.NewSecretKey()
+	if err != nil {
+		t.Fatalf("NewSecretKey failed: %v", err)
+	}
+	defer sk.Free()
+
+	chunkAddr, err := antffi.NewChunkAddress([]byte("Target chunk data"))
+	if err != nil {
+		t.Fatalf("NewChunkAddress failed: %v", err)
+	}
+	defer chunkAddr.Free()
+
+	target, err := antffi.NewPointerTargetChunk(chunkAddr)
+	if err != nil {
+		t.Fatalf("NewPointerTargetChunk failed: %v", err)
+	}
+	defer target.Free()
+
+	pointer, err := antffi.NewNetworkPointer(sk, 1, target)
+	if err != nil {
+		t.Fatalf("NewNetworkPointer failed: %v", err)
+	}
+	defer pointer.Free()
+
+	if err := pointer.Verify(); err != nil {
+		t.Fatalf("Verify failed for a freshly signed pointer: %v", err)
+	}
+
+	// A decoded copy keeps its valid signature
+	encoded, err := pointer.ToBytes()
+	if err != nil {
+		t.Fatalf("ToBytes failed: %v", err)
+	}
+
+	decoded, err := antffi.NetworkPointerFromBytes(encoded)
+	if err != nil {
+		t.Fatalf("NetworkPointerFromBytes failed: %v", err)
+	}
+	defer decoded.Free()
+
+	if err := decoded.Verify(); err != nil {
+		t.Fatalf("Verify failed for a decoded pointer: %v", err)
+	}
+
+	// Bump the counter while keeping the original signature
+	newer, err := antffi.NewNetworkPointer(sk, 2, target)
+	if err != nil {
+		t.Fatalf("NewNetworkPointer failed: %v", err)
+	}
+	defer newer.Free()
+
+	newerEncoded, err := newer.ToBytes()
+	if err != nil {
+		t.Fatalf("ToBytes failed: %v", err)
+	}
+
+	tampered, err := antffi.NetworkPointerFromBytes(spliceFirstDifference(t, encoded, newerEncoded))
+	if err != nil {
+		t.Fatalf("NetworkPointerFromBytes failed: %v", err)
+	}
+	defer tampered.Free()
+
+	counter, err := tampered.Counter()
+	if err != nil {
+		t.Fatalf("Counter failed: %v", err)
+	}
+	if counter != 2 {
+		t.Fatalf("Tampered counter mismatch: %d != 2", counter)
+	}
+
+	err = tampered.Verify()
+	if !errors.Is(err, antffi.ErrInvalidSignature) {
+		t.Fatalf("Expected ErrInvalidSignature, got %v", err)
+	}
+
+	var verr *antffi.VerificationError
+	if !errors.As(err, &verr) || verr.Kind != "pointer" {
+		t.Fatalf("Expected pointer VerificationError, got %v", err)
+	}
+}
+
+func TestScratchpadVerify(t *testing.T) {
+	sk, err := antffi.NewSecretKey()
+	if err != nil {
+		t.Fatalf("NewSecretKey failed: %v", err)
+	}
+	defer sk.Free()
+
+	data := []byte("Scratchpad payload")
+
+	scratchpad, err := antffi.NewScratchpad(sk, 1, data, 0)
+	if err != nil {
+		t.Fatalf("NewScratchpad failed: %v", err)
+	}
+	defer scratchpad.Free()
+
+	if err := scratchpad.Verify(); err != nil {
+		t.Fatalf("Verify failed for a freshly signed scratchpad: %v", err)
+	}
+
+	encoded, err := scratchpad.ToBytes()
+	if err != nil {
+		t.Fatalf("ToBytes failed: %v", err)
+	}
+
+	decoded, err := antffi.ScratchpadFromBytes(encoded)
+	if err != nil {
+		t.Fatalf("ScratchpadFromBytes failed: %v", err)
+	}
+	defer decoded.Free()
+
+	if err := decoded.Verify(); err != nil {
+		t.Fatalf("Verify failed for a decoded scratchpad: %v", err)
+	}
+
+	// Change the data encoding while keeping the original signature
+	other, err := antffi.NewScratchpad(sk, 2, data, 0)
+	if err != nil {
+		t.Fatalf("NewScratchpad failed: %v", err)
+	}
+	defer other.Free()
+
+	otherEncoded, err := other.ToBytes()
+	if err != nil {
+		t.Fatalf("ToBytes failed: %v", err)
+	}
+
+	tampered, err := antffi.ScratchpadFromBytes(spliceFirstDifference(t, encoded, otherEncoded))
+	if err != nil {
+		t.Fatalf("ScratchpadFromBytes failed: %v", err)
+	}
+	defer tampered.Free()
+
+	err = tampered.Verify()
+	if !errors.Is(err, antffi.ErrInvalidSignature) {
+		t.Fatalf("Expected ErrInvalidSignature, got %v", err)
+	}
+
+	var verr *antffi.VerificationError
+	if !errors.As(err, &verr) || verr.Kind != "scratchpad" {
+		t.Fatalf("Expected scratchpad VerificationError, got %v", err)
+	}
+}
+
+func TestRegisterValueFromBytes(t *testing.T) {
+	value, err := antffi.RegisterValueFromBytes([]byte("hello"))
+	if err != nil {
+		t.Fatalf("RegisterValueFromBytes failed: %v", err)
+	}
+	want := make([]byte, 32)
+	copy(want, "hello")
+	if !bytes.Equal(value, want) {
+		t.Fatalf("Expected a zero-padded 32-byte value, got %x", value)
+	}
+
+	// A register value converts to itself.
+	again, err := antffi.RegisterValueFromBytes(value)
+	if err != nil || !bytes.Equal(again, value) {
+		t.Fatalf("Round trip returned %x, %v", again, err)
+	}
+
+	if _, err := antffi.RegisterValueFromBytes(make([]byte, 33)); err == nil {
+		t.Fatal("Expected an error for a value longer than 32 bytes")
+	}
+}
@@ -0,0 +1,312 @@
+package antffi_test
+
+import (
+	"testing"
+
+	"github.com/maidsafe/ant-ffi/go/antffi"
+)
+
+func newTestPointer(tb testing.TB) (*antffi.SecretKey, *antffi.NetworkPointer) {
+	tb.Helper()
+
+	sk, err := antffi.NewSecretKey()
+	if err != nil {
+		tb.Fatalf("NewSecretKey failed: %v", err)
+	}
+
+	chunkAddr, err := antffi.NewChunkAddress([]byte("Target chunk data"))
+	if err != nil {
+		tb.Fatalf("NewChunkAddress failed: %v", err)
+	}
+	defer chunkAddr.Free()
+
+	target, err := antffi.NewPointerTargetChunk(chunkAddr)
+	if err != nil {
+		tb.Fatalf("NewPointerTargetChunk failed: %v", err)
+	}
+	defer target.Free()
+
+	pointer, err := antffi.NewNetworkPointer(sk, 5, target)
+	if err != nil {
+		tb.Fatalf("NewNetworkPointer failed: %v", err)
+	}
+	return sk, pointer
+}
+
+func TestNetworkPointerSnapshot(t *testing.T) {
+	sk, pointer := newTestPointer(t)
+	defer sk.Free()
+	defer pointer.Free()
+
+	snapshot, err := pointer.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot failed: %v", err)
+	}
+
+	address, err := pointer.Address()
+	if err != nil {
+		t.Fatalf("Address failed: %v", err)
+	}
+	defer address.Free()
+	addressHex, err := address.ToHex()
+	if err != nil {
+		t.Fatalf("ToHex failed: %v", err)
+	}
+
+	target, err := pointer.Target()
+	if err != nil {
+		t.Fatalf("Target failed: %v", err)
+	}
+	defer target.Free()
+	targetHex, err := target.ToHex()
+	if err != nil {
+		t.Fatalf("ToHex failed: %v", err)
+	}
+
+	pk, err := sk.PublicKey()
+	if err != nil {
+		t.Fatalf("PublicKey failed: %v", err)
+	}
+	defer pk.Free()
+	ownerHex, err := pk.ToHex()
+	if err != nil {
+		t.Fatalf("ToHex failed: %v", err)
+	}
+
+	want := antffi.PointerSnapshot{
+		Address:    addressHex,
+		Owner:      ownerHex,
+		Counter:    5,
+		TargetKind: antffi.PointerTargetChunk,
+		Target:     targetHex,
+	}
+	if snapshot != want {
+		t.Fatalf("Snapshot mismatch: %+v != %+v", snapshot, want)
+	}
+}
+
+func TestScratchpadSnapshot(t *testing.T) {
+	sk, err := antffi.NewSecretKey()
+	if err != nil {
+		t.Fatalf("NewSecretKey failed: %v", err)
+	}
+	defer sk.Free()
+
+	scratchpad, err := antffi.NewScratchpad(sk, 3, []byte("Scratchpad payload"), 9)
+	if err != nil {
+		t.Fatalf("NewScratchpad failed: %v", err)
+	}
+	defer scratchpad.Free()
+
+	snapshot, err := scratchpad.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot failed: %v", err)
+	}
+	if snapshot.DataEncoding != 3 || snapshot.Counter != 9 {
+		t.Fatalf("Unexpected encoding or counter: %+v", snapshot)
+	}
+
+	encrypted, err := scratchpad.EncryptedData()
+	if err != nil {
+		t.Fatalf("EncryptedData failed: %v", err)
+	}
+	if string(snapshot.EncryptedData) != string(encrypted) {
+		t.Fatal("Snapshot encrypted data differs from EncryptedData")
+	}
+
+	address, err := scratchpad.Address()
+	if err != nil {
+		t.Fatalf("Address failed: %v", err)
+	}
+	defer address.Free()
+	addressHex, err := address.ToHex()
+	if err != nil {
+		t.Fatalf("ToHex failed: %v", err)
+	}
+	if snapshot.Address != addressHex {
+		t.Fatalf("Address mismatch: %s != %s", snapshot.Address, addressHex)
+	}
+
+	owner, err := scratchpad.Owner()
+	if err != nil {
+		t.Fatalf("Owner failed: %v", err)
+	}
+	defer owner.Free()
+	ownerHex, err := owner.ToHex()
+	if err != nil {
+		t.Fatalf("ToHex failed: %v", err)
+	}
+	if snapshot.Owner != ownerHex {
+		t.Fatalf("Owner mismatch: %s != %s", snapshot.Owner, ownerHex)
+	}
+}
+
+func TestGraphEntrySnapshot(t *testing.T) {
+	owner, err := antffi.NewSecretKey()
+	if err != nil {
+		t.Fatalf("NewSecretKey failed: %v", err)
+	}
+	defer owner.Free()
+
+	var keyHexes []string
+	var publicKeys []*antffi.PublicKey
+	for i := 0; i < 2; i++ {
+		sk, err := antffi.NewSecretKey()
+		if err != nil {
+			t.Fatalf("NewSecretKey failed: %v", err)
+		}
+		defer sk.Free()
+
+		pk, err := sk.PublicKey()
+		if err != nil {
+			t.Fatalf("PublicKey failed: %v", err)
+		}
+		defer pk.Free()
+
+		hex, err := pk.ToHex()
+		if err != nil {
+			t.Fatalf("ToHex failed: %v", err)
+		}
+		keyHexes = append(keyHexes, hex)
+		publicKeys = append(publicKeys, pk)
+	}
+
+	var content, descendantContent [antffi.GraphContentSize]byte
+	copy(content[:], "graph entry content")
+	copy(descendantContent[:], "descendant content")
+
+	entry, err := antffi.NewGraphEntry(owner, publicKeys[:1], content, []antffi.GraphDescendant{
+		{PublicKey: publicKeys[1], Content: descendantContent},
+	})
+	if err != nil {
+		t.Fatalf("NewGraphEntry failed: %v", err)
+	}
+	defer entry.Free()
+
+	snapshot, err := entry.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot failed: %v", err)
+	}
+
+	if snapshot.Content != content {
+		t.Fatalf("Content mismatch: %x != %x", snapshot.Content, content)
+	}
+	if len(snapshot.Parents) != 1 || snapshot.Parents[0] != keyHexes[0] {
+		t.Fatalf("Parents mismatch: %v", snapshot.Parents)
+	}
+	if len(snapshot.Descendants) != 1 {
+		t.Fatalf("Expected 1 descendant, got %d", len(snapshot.Descendants))
+	}
+	if snapshot.Descendants[0].PublicKey != keyHexes[1] || snapshot.Descendants[0].Content != descendantContent {
+		t.Fatalf("Descendant mismatch: %+v", snapshot.Descendants[0])
+	}
+
+	address, err := entry.Address()
+	if err != nil {
+		t.Fatalf("Address failed: %v", err)
+	}
+	defer address.Free()
+	addressHex, err := address.ToHex()
+	if err != nil {
+		t.Fatalf("ToHex failed: %v", err)
+	}
+	if snapshot.Address != addressHex {
+		t.Fatalf("Address mismatch: %s != %s", snapshot.Address, addressHex)
+	}
+}
+
+func BenchmarkNetworkPointerGetters(b *testing.B) {
+	sk, pointer := newTestPointer(b)
+	defer sk.Free()
+	defer pointer.Free()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		address, err := pointer.Address()
+		if err != nil {
+			b.Fatal(err)
+		}
+		target, err := pointer.Target()
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := pointer.Counter(); err != nil {
+			b.Fatal(err)
+		}
+		address.Free()
+		target.Free()
+	}
+}
+
+func BenchmarkNetworkPointerSnapshot(b *testing.B) {
+	sk, pointer := newTestPointer(b)
+	defer sk.Free()
+	defer pointer.Free()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := pointer.Snapshot(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func newTestScratchpad(b *testing.B) (*antffi.SecretKey, *antffi.Scratchpad) {
+	b.Helper()
+
+	sk, err := antffi.NewSecretKey()
+	if err != nil {
+		b.Fatalf("NewSecretKey failed: %v", err)
+	}
+	scratchpad, err := antffi.NewScratchpad(sk, 1, make([]byte, 1024), 0)
+	if err != nil {
+		b.Fatalf("NewScratchpad failed: %v", err)
+	}
+	return sk, scratchpad
+}
+
+func BenchmarkScratchpadGetters(b *testing.B) {
+	sk, scratchpad := newTestScratchpad(b)
+	defer sk.Free()
+	defer scratchpad.Free()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		address, err := scratchpad.Address()
+		if err != nil {
+			b.Fatal(err)
+		}
+		owner, err := scratchpad.Owner()
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := scratchpad.DataEncoding(); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := scratchpad.Counter(); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := scratchpad.EncryptedData(); err != nil {
+			b.Fatal(err)
+		}
+		address.Free()
+		owner.Free()
+	}
+}
+
+func BenchmarkScratchpadSnapshot(b *testing.B) {
+	sk, scratchpad := newTestScratchpad(b)
+	defer sk.Free()
+	defer scratchpad.Free()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := scratchpad.Snapshot(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
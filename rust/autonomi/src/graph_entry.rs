@@ -44,6 +44,26 @@ pub struct GraphDescendant {
     pub content: Vec<u8>, // [u8; 32] but UniFFI doesn't support fixed arrays
 }
 
+/// A parent or descendant reference with the key as hex, for snapshots
+#[derive(uniffi::Record, Clone, Debug)]
+pub struct GraphDescendantSnapshot {
+    pub public_key: String,
+    pub content: Vec<u8>,
+}
+
+/// All fields of a graph entry as plain values, so they can be read in one call
+#[derive(uniffi::Record, Clone, Debug)]
+pub struct GraphEntrySnapshot {
+    /// Hex address where the entry is stored
+    pub address: String,
+    /// Hex public key of the owner
+    pub owner: String,
+    pub content: Vec<u8>,
+    /// Hex public keys of the parents
+    pub parents: Vec<String>,
+    pub descendants: Vec<GraphDescendantSnapshot>,
+}
+
 /// A graph entry that can be stored on the network
 #[derive(uniffi::Object, Clone, Debug)]
 pub struct GraphEntry {
@@ -131,6 +151,25 @@ impl GraphEntry {
             })
             .collect()
     }
+
+    /// Get every field at once, with keys as hex instead of objects
+    pub fn snapshot(&self) -> GraphEntrySnapshot {
+        GraphEntrySnapshot {
+            address: self.inner.address().to_hex(),
+            owner: self.inner.owner.to_hex(),
+            content: self.inner.content.to_vec(),
+            parents: self.inner.parents.iter().map(|p| p.to_hex()).collect(),
+            descendants: self
+                .inner
+                .descendants
+                .iter()
+                .map(|(pk, c)| GraphDescendantSnapshot {
+                    public_key: pk.to_hex(),
+                    content: c.to_vec(),
+                })
+                .collect(),
+        }
+    }
 }
 
 /// Errors that can occur when working with graph entries
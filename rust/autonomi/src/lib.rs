@@ -17,8 +17,10 @@
 //! - **Signatures**: BLS signature creation and verification
 //! - **Self-encryption**: Encrypt/decrypt data
 //! - **Registers**: Mutable versioned storage with history collection
-//! - **Vaults**: Encrypted user data storage (see `vault` module for missing UserData mutation)
+//! - **Vaults**: Encrypted user data storage and UserData archive references
 //! - **Streaming**: DataStream for memory-efficient large data handling
+//! - **Uploads**: DataUpload for incremental uploads of large data
+//! - **Progress**: ProgressListener callbacks for uploads and downloads
 //!
 //! ### ❌ Remaining Missing Features (Available in Python Bindings)
 //!
@@ -58,10 +60,12 @@ mod graph_entry;
 mod key_derivation;
 mod keys;
 mod pointer;
+mod progress;
 mod registers;
 mod scratchpad;
 mod self_encryption;
 mod streaming;
+mod upload;
 mod vault;
 
 // Re-export data types
@@ -70,17 +74,25 @@ pub use archive::{
     PrivateArchiveFileEntry, PublicArchive, PublicArchiveFileEntry,
 };
 pub use data::{Chunk, ChunkAddress, DataAddress, DataError, DataMapChunk};
-pub use graph_entry::{GraphDescendant, GraphEntry, GraphEntryAddress, GraphEntryError};
+pub use graph_entry::{
+    GraphDescendant, GraphDescendantSnapshot, GraphEntry, GraphEntryAddress, GraphEntryError,
+    GraphEntrySnapshot,
+};
 pub use key_derivation::{
     DerivationIndex, DerivedPubkey, DerivedSecretKey, MainPubkey, MainSecretKey, Signature,
 };
 pub use keys::{KeyError, PublicKey, SecretKey};
-pub use pointer::{NetworkPointer, PointerAddress, PointerError, PointerTarget};
+pub use pointer::{
+    NetworkPointer, PointerAddress, PointerError, PointerSnapshot, PointerTarget, PointerTargetKind,
+};
 pub use registers::{
-    RegisterAddress, RegisterError, register_key_from_name, register_value_from_bytes,
+    RegisterAddress, RegisterError, RegisterHistory, register_key_from_name,
+    register_value_from_bytes,
 };
-pub use scratchpad::{Scratchpad, ScratchpadAddress, ScratchpadError};
+pub use scratchpad::{Scratchpad, ScratchpadAddress, ScratchpadError, ScratchpadSnapshot};
+pub use progress::{ProgressEvent, ProgressListener};
 pub use streaming::DataStream;
+pub use upload::DataUpload;
 pub use vault::{
     FileArchiveEntry, PrivateFileArchiveEntry, UserData, VaultError, VaultGetResult, VaultSecretKey,
 };
@@ -222,6 +234,8 @@ pub enum ClientError {
     InitializationFailed { reason: String },
     #[error("Invalid data address: {reason}")]
     InvalidAddress { reason: String },
+    #[error("Invalid input: {reason}")]
+    InvalidInput { reason: String },
 }
 
 /// Error type for Wallet operations
@@ -621,7 +635,11 @@ impl Client {
             }
         })?;
 
-        Ok(streaming::DataStream::new(stream))
+        Ok(streaming::DataStream::new(
+            self.inner.clone(),
+            streaming::StreamSource::Private(data_map),
+            stream,
+        ))
     }
 
     /// Stream public data from the network.
@@ -639,7 +657,17 @@ impl Client {
                 reason: e.to_string(),
             })?;
 
-        Ok(streaming::DataStream::new(stream))
+        Ok(streaming::DataStream::new(
+            self.inner.clone(),
+            streaming::StreamSource::Public(address),
+            stream,
+        ))
+    }
+
+    /// Start an incremental upload of `size` bytes.
+    /// Feed the data with `DataUpload::write`, then call `finish` or `finish_public`.
+    pub fn data_upload(&self, size: u64) -> Result<Arc<DataUpload>, ClientError> {
+        upload::DataUpload::new(self.inner.clone(), size)
     }
 
     /// Get a pointer from the network by its address
@@ -1073,6 +1101,12 @@ impl Client {
         Ok(cost.to_string())
     }
 
+    /// Iterate over the history of a register, from root to latest entry.
+    /// Values are fetched one at a time by `RegisterHistory::next`.
+    pub fn register_history(&self, address: Arc<RegisterAddress>) -> Arc<RegisterHistory> {
+        RegisterHistory::new(self.inner.register_history(&address.inner))
+    }
+
     /// Get the complete history of a register, from root to latest entry.
     ///
     /// Returns a list of 32-byte register values in chronological order.
@@ -1092,9 +1126,6 @@ impl Client {
     }
 
     // ===== Vault Methods =====
-    //
-    // ❌ MISSING Vault APIs (Future Work):
-    // - UserData mutation: add_file_archive, add_private_file_archive, remove_file_archive
 
     /// Get the cost to create a vault with the given maximum expected size
     ///
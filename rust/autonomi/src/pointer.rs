@@ -5,6 +5,8 @@
 //! - ✅ NetworkPointer: Versioned mutable pointer with counter
 //! - ✅ PointerTarget: Can point to Chunk, Pointer, GraphEntry, or Scratchpad
 //! - ✅ Client methods: pointer_create, pointer_update, pointer_update_from, pointer_get, pointer_put, pointer_cost, pointer_check_existence
+//! - ✅ Serialization: to_bytes, from_bytes (MessagePack, for caching and relaying)
+//! - ✅ Snapshot: every field as plain values in a single call
 //! - ✅ Static verification: pointer_verify
 
 use autonomi::pointer::{
@@ -66,6 +68,39 @@ impl PointerAddress {
     }
 }
 
+/// The kind of address a pointer target refers to
+#[derive(uniffi::Enum, Clone, Copy, Debug, PartialEq, Eq)]
+pub enum PointerTargetKind {
+    Chunk,
+    Pointer,
+    GraphEntry,
+    Scratchpad,
+}
+
+impl From<&AutonomiPointerTarget> for PointerTargetKind {
+    fn from(target: &AutonomiPointerTarget) -> Self {
+        match target {
+            AutonomiPointerTarget::ChunkAddress(_) => Self::Chunk,
+            AutonomiPointerTarget::PointerAddress(_) => Self::Pointer,
+            AutonomiPointerTarget::GraphEntryAddress(_) => Self::GraphEntry,
+            AutonomiPointerTarget::ScratchpadAddress(_) => Self::Scratchpad,
+        }
+    }
+}
+
+/// All fields of a pointer as plain values, so they can be read in one call
+#[derive(uniffi::Record, Clone, Debug)]
+pub struct PointerSnapshot {
+    /// Hex address where the pointer is stored
+    pub address: String,
+    /// Hex public key of the owner
+    pub owner: String,
+    pub counter: u64,
+    pub target_kind: PointerTargetKind,
+    /// Hex address of the target
+    pub target: String,
+}
+
 /// A mutable pointer to data on the network
 /// Stored at the owner's public key address and can only be updated by the owner
 #[derive(uniffi::Object, Clone, Debug)]
@@ -102,6 +137,36 @@ impl NetworkPointer {
     pub fn counter(&self) -> u64 {
         self.inner.counter()
     }
+
+    /// Get every field at once, avoiding a call and an object per field
+    pub fn snapshot(&self) -> PointerSnapshot {
+        let target = self.inner.target();
+        PointerSnapshot {
+            address: self.inner.address().to_hex(),
+            owner: self.inner.owner().to_hex(),
+            counter: self.inner.counter(),
+            target_kind: target.into(),
+            target: target.to_hex(),
+        }
+    }
+
+    /// Deserialize a pointer from its MessagePack encoding
+    /// The signature is not checked; use pointer_verify before trusting the result
+    #[uniffi::constructor]
+    pub fn from_bytes(bytes: Vec<u8>) -> Result<Arc<Self>, PointerError> {
+        let inner: AutonomiPointer =
+            rmp_serde::from_slice(&bytes).map_err(|e| PointerError::ParsingFailed {
+                reason: format!("Failed to decode pointer: {}", e),
+            })?;
+        Ok(Arc::new(Self { inner }))
+    }
+
+    /// Serialize the pointer, including its signature, to MessagePack
+    pub fn to_bytes(&self) -> Result<Vec<u8>, PointerError> {
+        rmp_serde::to_vec(&self.inner).map_err(|e| PointerError::InvalidPointer {
+            reason: format!("Failed to encode pointer: {}", e),
+        })
+    }
 }
 
 /// The target that a pointer can point to on the network
@@ -0,0 +1,388 @@
+//! Progress module - Progress events for long-running transfers
+//!
+//! ## Current Implementation
+//! - ✅ ProgressListener: Callback interface implemented by the foreign side
+//! - ✅ ProgressEvent: Transfer and file start/finish, bytes and chunks done, payments
+//! - ✅ Client methods: data_put_with_progress, file_upload_with_progress,
+//!   file_download_with_progress, dir_upload_with_progress, dir_download_with_progress
+//!
+//! Downloads report every chunk as it is decrypted.
+//!
+//! autonomi does not expose per-chunk upload progress, so uploads forward the
+//! `UploadComplete` client events it emits while storing the data. Each one is
+//! reported as it arrives, as `PaymentMade` with the tokens spent followed by
+//! `Transferred` with the chunks stored and no bytes. Once the upload returns,
+//! a single `Transferred` reports all the bytes with no chunks, then `Finished`.
+
+use autonomi::client::data::DataStream as AutonomiDataStream;
+use autonomi::client::payment::PaymentOption as AutonomiPaymentOption;
+use autonomi::client::ClientEvent;
+use bytes::Bytes;
+use std::fs::File;
+use std::future::Future;
+use std::io::Write;
+use std::path::{Path, PathBuf};
+use std::sync::Arc;
+use tokio::sync::mpsc;
+
+use crate::{
+    Client, ClientError, DataMapChunk, DataPutResult, DirUploadResult, FileUploadResult,
+    PaymentOption, PrivateArchiveDataMap, Wallet,
+};
+
+/// Self-encryption's maximum chunk size, used to estimate chunk counts
+const MAX_CHUNK_SIZE: u64 = 4 * 1024 * 1024;
+
+/// Receives progress events from a transfer.
+///
+/// Events are delivered on the thread doing the transfer, so implementations
+/// should return quickly.
+#[uniffi::export(callback_interface)]
+pub trait ProgressListener: Send + Sync {
+    fn on_event(&self, event: ProgressEvent);
+}
+
+/// A step in a transfer. Byte and chunk counts are increments, not running totals.
+#[derive(uniffi::Enum, Clone, Debug)]
+pub enum ProgressEvent {
+    /// The transfer began. `total_chunks` is estimated from the data size.
+    Started {
+        total_bytes: u64,
+        total_chunks: u64,
+        total_files: u64,
+    },
+    /// Work on a file began
+    FileStarted { path: String, bytes: u64 },
+    /// More data was transferred
+    Transferred { bytes: u64, chunks: u64 },
+    /// A file was finished
+    FileFinished { path: String },
+    /// Uploaded data was paid for
+    PaymentMade { cost: String },
+    /// The transfer finished
+    Finished,
+}
+
+/// Estimated number of chunks self-encryption splits `bytes` bytes into
+fn estimated_chunks(bytes: u64) -> u64 {
+    if bytes == 0 {
+        return 0;
+    }
+    bytes.div_ceil(MAX_CHUNK_SIZE).max(3)
+}
+
+fn io_error(context: &str, e: std::io::Error) -> ClientError {
+    ClientError::NetworkError {
+        reason: format!("{}: {}", context, e),
+    }
+}
+
+fn network_error(e: impl std::fmt::Display) -> ClientError {
+    ClientError::NetworkError {
+        reason: e.to_string(),
+    }
+}
+
+fn to_autonomi_payment(payment: PaymentOption) -> AutonomiPaymentOption {
+    match payment {
+        PaymentOption::WalletPayment { wallet_ref } => {
+            AutonomiPaymentOption::Wallet(wallet_ref.inner.clone())
+        }
+    }
+}
+
+/// List the files under `root` with their sizes
+fn collect_files(root: &Path) -> Result<Vec<(PathBuf, u64)>, ClientError> {
+    let mut files = Vec::new();
+    let mut pending = vec![root.to_path_buf()];
+
+    while let Some(dir) = pending.pop() {
+        let entries = std::fs::read_dir(&dir)
+            .map_err(|e| io_error("Failed to read directory", e))?;
+        for entry in entries {
+            let entry = entry.map_err(|e| io_error("Failed to read directory", e))?;
+            let path = entry.path();
+            let meta = entry
+                .metadata()
+                .map_err(|e| io_error("Failed to read file metadata", e))?;
+            if meta.is_dir() {
+                pending.push(path);
+            } else if meta.is_file() {
+                files.push((path, meta.len()));
+            }
+        }
+    }
+
+    files.sort();
+    Ok(files)
+}
+
+/// Write every chunk of `stream` to `path`, reporting each one
+fn write_stream(
+    stream: AutonomiDataStream,
+    path: &Path,
+    listener: &dyn ProgressListener,
+) -> Result<(), ClientError> {
+    if let Some(parent) = path.parent() {
+        std::fs::create_dir_all(parent)
+            .map_err(|e| io_error("Failed to create directory", e))?;
+    }
+    let mut file = File::create(path)
+        .map_err(|e| io_error("Failed to create file", e))?;
+
+    for chunk in stream {
+        let chunk = chunk.map_err(|e| ClientError::NetworkError {
+            reason: format!("Stream error: {}", e),
+        })?;
+        file.write_all(&chunk)
+            .map_err(|e| io_error("Failed to write file", e))?;
+        listener.on_event(ProgressEvent::Transferred {
+            bytes: chunk.len() as u64,
+            chunks: 1,
+        });
+    }
+
+    file.flush().map_err(|e| io_error("Failed to write file", e))
+}
+
+/// Run `write_stream` off the async runtime, since the stream fetches chunks
+/// as it is iterated
+async fn save_stream(
+    stream: AutonomiDataStream,
+    path: PathBuf,
+    listener: Arc<dyn ProgressListener>,
+) -> Result<(), ClientError> {
+    tokio::task::spawn_blocking(move || write_stream(stream, &path, listener.as_ref()))
+        .await
+        .map_err(network_error)?
+}
+
+/// Report a client event emitted during an upload
+fn report_upload_event(event: ClientEvent, listener: &dyn ProgressListener) {
+    #[allow(irrefutable_let_patterns)]
+    if let ClientEvent::UploadComplete(summary) = event {
+        listener.on_event(ProgressEvent::PaymentMade {
+            cost: summary.tokens_spent.to_string(),
+        });
+        listener.on_event(ProgressEvent::Transferred {
+            bytes: 0,
+            chunks: (summary.records_paid + summary.records_already_paid) as u64,
+        });
+    }
+}
+
+/// Drive `upload` to completion, reporting the client events it emits as they
+/// arrive. Events sent before the upload returned are all reported before this does.
+async fn forward_upload_events<T>(
+    mut events: mpsc::Receiver<ClientEvent>,
+    upload: impl Future<Output = Result<T, ClientError>>,
+    listener: &dyn ProgressListener,
+) -> Result<T, ClientError> {
+    let mut upload = std::pin::pin!(upload);
+    loop {
+        tokio::select! {
+            biased;
+            Some(event) = events.recv() => report_upload_event(event, listener),
+            result = &mut upload => {
+                while let Ok(event) = events.try_recv() {
+                    report_upload_event(event, listener);
+                }
+                return result;
+            }
+        }
+    }
+}
+
+#[uniffi::export(async_runtime = "tokio")]
+impl Client {
+    /// Upload private data, reporting progress to `listener`
+    pub async fn data_put_with_progress(
+        &self,
+        data: Vec<u8>,
+        payment: PaymentOption,
+        listener: Box<dyn ProgressListener>,
+    ) -> Result<DataPutResult, ClientError> {
+        let total = data.len() as u64;
+        listener.on_event(ProgressEvent::Started {
+            total_bytes: total,
+            total_chunks: estimated_chunks(total),
+            total_files: 0,
+        });
+
+        let mut client = (*self.inner).clone();
+        let events = client.enable_client_events();
+        let upload = async {
+            client
+                .data_put(Bytes::from(data), to_autonomi_payment(payment))
+                .await
+                .map_err(network_error)
+        };
+        let (cost, data_map) = forward_upload_events(events, upload, listener.as_ref()).await?;
+
+        listener.on_event(ProgressEvent::Transferred {
+            bytes: total,
+            chunks: 0,
+        });
+        listener.on_event(ProgressEvent::Finished);
+
+        Ok(DataPutResult {
+            cost: cost.to_string(),
+            data_map: Arc::new(DataMapChunk { inner: data_map }),
+        })
+    }
+
+    /// Upload a private file, reporting progress to `listener`
+    pub async fn file_upload_with_progress(
+        &self,
+        path: String,
+        payment: PaymentOption,
+        listener: Box<dyn ProgressListener>,
+    ) -> Result<FileUploadResult, ClientError> {
+        let bytes = std::fs::metadata(&path)
+            .map_err(|e| io_error("Failed to read file metadata", e))?
+            .len();
+        listener.on_event(ProgressEvent::Started {
+            total_bytes: bytes,
+            total_chunks: estimated_chunks(bytes),
+            total_files: 1,
+        });
+        listener.on_event(ProgressEvent::FileStarted {
+            path: path.clone(),
+            bytes,
+        });
+
+        let mut client = (*self.inner).clone();
+        let events = client.enable_client_events();
+        let upload = async {
+            client
+                .file_content_upload(PathBuf::from(&path), to_autonomi_payment(payment).into())
+                .await
+                .map_err(network_error)
+        };
+        let (cost, data_map) = forward_upload_events(events, upload, listener.as_ref()).await?;
+
+        listener.on_event(ProgressEvent::Transferred { bytes, chunks: 0 });
+        listener.on_event(ProgressEvent::FileFinished { path });
+        listener.on_event(ProgressEvent::Finished);
+
+        Ok(FileUploadResult {
+            cost: cost.to_string(),
+            data_map: Arc::new(DataMapChunk { inner: data_map }),
+        })
+    }
+
+    /// Download a private file to `path`, reporting each chunk to `listener`
+    pub async fn file_download_with_progress(
+        &self,
+        data_map: Arc<DataMapChunk>,
+        path: String,
+        listener: Box<dyn ProgressListener>,
+    ) -> Result<(), ClientError> {
+        let listener: Arc<dyn ProgressListener> = Arc::from(listener);
+        let stream = self
+            .inner
+            .data_stream(&data_map.inner)
+            .await
+            .map_err(network_error)?;
+        let bytes = stream.data_size() as u64;
+
+        listener.on_event(ProgressEvent::Started {
+            total_bytes: bytes,
+            total_chunks: estimated_chunks(bytes),
+            total_files: 1,
+        });
+        listener.on_event(ProgressEvent::FileStarted {
+            path: path.clone(),
+            bytes,
+        });
+        save_stream(stream, PathBuf::from(&path), listener.clone()).await?;
+        listener.on_event(ProgressEvent::FileFinished { path });
+        listener.on_event(ProgressEvent::Finished);
+        Ok(())
+    }
+
+    /// Upload a directory as a private archive with `dir_upload`, reporting
+    /// progress to `listener`
+    pub async fn dir_upload_with_progress(
+        &self,
+        path: String,
+        wallet: Arc<Wallet>,
+        listener: Box<dyn ProgressListener>,
+    ) -> Result<DirUploadResult, ClientError> {
+        let files = collect_files(Path::new(&path))?;
+        let total: u64 = files.iter().map(|(_, bytes)| bytes).sum();
+        listener.on_event(ProgressEvent::Started {
+            total_bytes: total,
+            total_chunks: files.iter().map(|(_, bytes)| estimated_chunks(*bytes)).sum(),
+            total_files: files.len() as u64,
+        });
+
+        let mut client = (*self.inner).clone();
+        let events = client.enable_client_events();
+        let upload = async {
+            client
+                .dir_upload(PathBuf::from(&path), &wallet.inner)
+                .await
+                .map_err(network_error)
+        };
+        let (cost, data_map) = forward_upload_events(events, upload, listener.as_ref()).await?;
+
+        listener.on_event(ProgressEvent::Transferred {
+            bytes: total,
+            chunks: 0,
+        });
+        listener.on_event(ProgressEvent::Finished);
+
+        Ok(DirUploadResult {
+            cost: cost.to_string(),
+            data_map: Arc::new(PrivateArchiveDataMap { inner: data_map }),
+        })
+    }
+
+    /// Download a private directory to `path`, file by file, reporting each
+    /// chunk to `listener`
+    pub async fn dir_download_with_progress(
+        &self,
+        data_map: Arc<PrivateArchiveDataMap>,
+        path: String,
+        listener: Box<dyn ProgressListener>,
+    ) -> Result<(), ClientError> {
+        let listener: Arc<dyn ProgressListener> = Arc::from(listener);
+        let archive = self
+            .inner
+            .archive_get(&data_map.inner)
+            .await
+            .map_err(network_error)?;
+
+        let files: Vec<_> = archive
+            .map()
+            .iter()
+            .map(|(file_path, (file_map, meta))| (file_path.clone(), file_map.clone(), meta.size))
+            .collect();
+        listener.on_event(ProgressEvent::Started {
+            total_bytes: files.iter().map(|(_, _, bytes)| bytes).sum(),
+            total_chunks: files.iter().map(|(_, _, bytes)| estimated_chunks(*bytes)).sum(),
+            total_files: files.len() as u64,
+        });
+
+        let root = PathBuf::from(path);
+        for (file_path, file_map, bytes) in files {
+            let dest = root.join(&file_path);
+            let display = dest.to_string_lossy().to_string();
+            listener.on_event(ProgressEvent::FileStarted {
+                path: display.clone(),
+                bytes,
+            });
+            let stream = self
+                .inner
+                .data_stream(&file_map)
+                .await
+                .map_err(network_error)?;
+            save_stream(stream, dest, listener.clone()).await?;
+            listener.on_event(ProgressEvent::FileFinished { path: display });
+        }
+
+        listener.on_event(ProgressEvent::Finished);
+        Ok(())
+    }
+}
@@ -6,12 +6,17 @@
 //!
 //! ## Current Implementation
 //! - ✅ RegisterAddress: Address derived from owner's public key
-//! - ✅ Client methods: register_create, register_update, register_get, register_cost, register_history_collect
+//! - ✅ RegisterHistory: Iterator fetching one register value per `next` call
+//! - ✅ Client methods: register_create, register_update, register_get, register_cost,
+//!   register_history, register_history_collect
 //! - ✅ Helper functions: register_key_from_name, register_value_from_bytes
 
 use autonomi::register::RegisterAddress as AutonomiRegisterAddress;
+use autonomi::register::RegisterHistory as AutonomiRegisterHistory;
 use std::sync::Arc;
+use tokio::sync::Mutex;
 
+use crate::ClientError;
 use crate::keys::{PublicKey, SecretKey};
 
 /// Error type for register operations
@@ -84,3 +89,37 @@ pub fn register_value_from_bytes(bytes: Vec<u8>) -> Result<Vec<u8>, RegisterErro
     })?;
     Ok(value.to_vec())
 }
+
+/// Iterator over the values a register has held, from the root entry to the latest.
+///
+/// Each `next` call fetches one entry from the network, so the history is never
+/// held in memory as a whole.
+#[derive(uniffi::Object)]
+pub struct RegisterHistory {
+    inner: Mutex<AutonomiRegisterHistory>,
+}
+
+impl RegisterHistory {
+    pub(crate) fn new(history: AutonomiRegisterHistory) -> Arc<Self> {
+        Arc::new(Self {
+            inner: Mutex::new(history),
+        })
+    }
+}
+
+#[uniffi::export(async_runtime = "tokio")]
+impl RegisterHistory {
+    /// Fetch the next 32-byte register value.
+    /// Returns None once the history is exhausted.
+    pub async fn next(&self) -> Result<Option<Vec<u8>>, ClientError> {
+        let mut history = self.inner.lock().await;
+        let value = history
+            .next()
+            .await
+            .map_err(|e| ClientError::NetworkError {
+                reason: e.to_string(),
+            })?;
+
+        Ok(value.map(|v| v.to_vec()))
+    }
+}
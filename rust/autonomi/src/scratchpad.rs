@@ -6,6 +6,8 @@
 //! - ✅ Methods: decrypt_data, owner, counter, data_encoding, scratchpad_hash, encrypted_data_hash, encrypted_data
 //! - ✅ Client methods: scratchpad_create, scratchpad_update, scratchpad_update_from, scratchpad_get
 //! - ✅ Client methods: scratchpad_put, scratchpad_put_update, scratchpad_get_from_public_key, scratchpad_cost
+//! - ✅ Serialization: to_bytes, from_bytes (MessagePack, for caching and relaying)
+//! - ✅ Snapshot: every public field as plain values in a single call
 //! - ✅ Static verification: scratchpad_verify
 //!
 //! ## Missing APIs (available in Python bindings)
@@ -71,6 +73,18 @@ impl ScratchpadAddress {
     }
 }
 
+/// All public fields of a scratchpad as plain values, so they can be read in one call
+#[derive(uniffi::Record, Clone, Debug)]
+pub struct ScratchpadSnapshot {
+    /// Hex address where the scratchpad is stored
+    pub address: String,
+    /// Hex public key of the owner
+    pub owner: String,
+    pub data_encoding: u64,
+    pub counter: u64,
+    pub encrypted_data: Vec<u8>,
+}
+
 /// Scratchpad - encrypted mutable data with versioning
 /// Stored at the owner's public key address, only updatable by the owner
 #[derive(uniffi::Object, Clone, Debug)]
@@ -149,4 +163,33 @@ impl Scratchpad {
     pub fn encrypted_data(&self) -> Vec<u8> {
         self.inner.encrypted_data().to_vec()
     }
+
+    /// Get every public field at once, avoiding a call and an object per field
+    pub fn snapshot(&self) -> ScratchpadSnapshot {
+        ScratchpadSnapshot {
+            address: self.inner.address().to_hex(),
+            owner: self.inner.owner().to_hex(),
+            data_encoding: self.inner.data_encoding(),
+            counter: self.inner.counter(),
+            encrypted_data: self.inner.encrypted_data().to_vec(),
+        }
+    }
+
+    /// Deserialize a scratchpad from its MessagePack encoding
+    /// The signature is not checked; use scratchpad_verify before trusting the result
+    #[uniffi::constructor]
+    pub fn from_bytes(bytes: Vec<u8>) -> Result<Arc<Self>, ScratchpadError> {
+        let inner: AutonomiScratchpad =
+            rmp_serde::from_slice(&bytes).map_err(|e| ScratchpadError::ParsingFailed {
+                reason: format!("Failed to decode scratchpad: {}", e),
+            })?;
+        Ok(Arc::new(Self { inner }))
+    }
+
+    /// Serialize the scratchpad, including its signature, to MessagePack
+    pub fn to_bytes(&self) -> Result<Vec<u8>, ScratchpadError> {
+        rmp_serde::to_vec(&self.inner).map_err(|e| ScratchpadError::InvalidScratchpad {
+            reason: format!("Failed to encode scratchpad: {}", e),
+        })
+    }
 }
@@ -1,28 +1,88 @@
 //! Data streaming module - Memory-efficient streaming for large data
 //!
 //! ## Current Implementation
-//! - ✅ DataStream: Wrapper for streaming large data in chunks
+//! - ✅ DataStream: Wrapper for streaming large data in chunks, with byte ranges
+//!   fetched concurrently on separate streams over the same data
 //! - ✅ Client methods: data_stream, data_stream_public
 
 use autonomi::client::data::DataStream as AutonomiDataStream;
 use std::sync::{Arc, Mutex};
 
-use crate::ClientError;
+use crate::{ClientError, DataAddress, DataMapChunk};
+
+/// Most idle `get_range` streams a DataStream keeps for reuse. Streams opened
+/// beyond this for a burst of concurrent calls are dropped once they finish.
+const MAX_IDLE_RANGE_STREAMS: usize = 4;
+
+/// The data a stream reads, kept so more streams over it can be opened
+#[derive(Clone)]
+pub(crate) enum StreamSource {
+    Private(Arc<DataMapChunk>),
+    Public(Arc<DataAddress>),
+}
 
 /// A stream for reading large data in chunks without loading everything into memory.
 ///
 /// Use `next_chunk()` to get the next chunk of data, or `collect_all()` to get all data at once.
+/// `get_range()` may be called from several threads at once; each concurrent call reads
+/// through its own stream over the data, so ranges are fetched in parallel.
 #[derive(uniffi::Object)]
 pub struct DataStream {
-    inner: Mutex<AutonomiDataStream>,
+    client: Arc<autonomi::Client>,
+    source: StreamSource,
+    size: u64,
+    /// The stream iterated by `next_chunk`
+    chunks: Mutex<AutonomiDataStream>,
+    /// Idle streams for `get_range`, opened on demand and reused, at most
+    /// `MAX_IDLE_RANGE_STREAMS`
+    ranges: Mutex<Vec<AutonomiDataStream>>,
 }
 
 impl DataStream {
-    pub(crate) fn new(stream: AutonomiDataStream) -> Arc<Self> {
+    pub(crate) fn new(
+        client: Arc<autonomi::Client>,
+        source: StreamSource,
+        stream: AutonomiDataStream,
+    ) -> Arc<Self> {
         Arc::new(Self {
-            inner: Mutex::new(stream),
+            client,
+            source,
+            size: stream.data_size() as u64,
+            chunks: Mutex::new(stream),
+            ranges: Mutex::new(Vec::new()),
         })
     }
+
+    /// Take an idle stream for a range read, opening a new one if all are in use
+    fn take_range_stream(&self) -> Result<AutonomiDataStream, ClientError> {
+        let idle = self
+            .ranges
+            .lock()
+            .map_err(|e| ClientError::NetworkError {
+                reason: format!("Lock error: {}", e),
+            })?
+            .pop();
+        if let Some(stream) = idle {
+            return Ok(stream);
+        }
+
+        let client = self.client.clone();
+        let source = self.source.clone();
+        crate::get_blocking_runtime()
+            .block_on(async move {
+                match source {
+                    StreamSource::Private(data_map) => client
+                        .data_stream(&data_map.inner)
+                        .await
+                        .map_err(|e| e.to_string()),
+                    StreamSource::Public(address) => client
+                        .data_stream_public(&address.inner)
+                        .await
+                        .map_err(|e| e.to_string()),
+                }
+            })
+            .map_err(|reason| ClientError::NetworkError { reason })
+    }
 }
 
 #[uniffi::export]
@@ -30,7 +90,7 @@ impl DataStream {
     /// Get the next chunk of data from the stream.
     /// Returns None when the stream is exhausted.
     pub fn next_chunk(&self) -> Result<Option<Vec<u8>>, ClientError> {
-        let mut stream = self.inner.lock().map_err(|e| ClientError::NetworkError {
+        let mut stream = self.chunks.lock().map_err(|e| ClientError::NetworkError {
             reason: format!("Lock error: {}", e),
         })?;
 
@@ -55,11 +115,7 @@ impl DataStream {
 
     /// Get the original data size in bytes.
     pub fn data_size(&self) -> Result<u64, ClientError> {
-        let stream = self.inner.lock().map_err(|e| ClientError::NetworkError {
-            reason: format!("Lock error: {}", e),
-        })?;
-
-        Ok(stream.data_size() as u64)
+        Ok(self.size)
     }
 
     /// Decrypts and returns a specific byte range from the encrypted data.
@@ -68,17 +124,24 @@ impl DataStream {
     /// * `start` - The starting byte position (inclusive)
     /// * `length` - The number of bytes to read
     ///
-    /// Returns the decrypted bytes for the requested range.
+    /// Returns the decrypted bytes for the requested range. The range is read
+    /// through a stream no other call is using, so concurrent calls do not wait
+    /// for each other or for `next_chunk`.
     pub fn get_range(&self, start: u64, length: u64) -> Result<Vec<u8>, ClientError> {
-        let stream = self.inner.lock().map_err(|e| ClientError::NetworkError {
-            reason: format!("Lock error: {}", e),
-        })?;
+        let stream = self.take_range_stream()?;
 
-        stream
+        let range = stream
             .get_range(start as usize, length as usize)
             .map(|bytes| bytes.to_vec())
             .map_err(|e| ClientError::NetworkError {
                 reason: format!("Read range error: {}", e),
-            })
+            });
+
+        if let Ok(mut idle) = self.ranges.lock() {
+            if idle.len() < MAX_IDLE_RANGE_STREAMS {
+                idle.push(stream);
+            }
+        }
+        range
     }
 }
@@ -0,0 +1,173 @@
+//! Upload module - Incremental uploads of large data
+//!
+//! ## Current Implementation
+//! - ✅ DataUpload: Receives data piece by piece and spools it to a temporary file,
+//!   so neither side holds the whole payload in memory
+//! - ✅ Methods: write, written, finish (private), finish_public
+//! - ✅ Client method: data_upload
+
+use autonomi::client::payment::PaymentOption as AutonomiPaymentOption;
+use std::fs::File;
+use std::io::Write;
+use std::path::PathBuf;
+use std::sync::atomic::{AtomicU64, Ordering};
+use std::sync::{Arc, Mutex};
+
+use crate::{ClientError, DataMapChunk, DataPutResult, PaymentOption, UploadResult};
+
+static NEXT_SPOOL: AtomicU64 = AtomicU64::new(0);
+
+/// The temporary file holding the data received so far
+struct Spool {
+    path: PathBuf,
+    file: Option<File>,
+    written: u64,
+}
+
+impl Drop for Spool {
+    fn drop(&mut self) {
+        self.file.take();
+        let _ = std::fs::remove_file(&self.path);
+    }
+}
+
+/// An upload fed incrementally with `write`, then sent to the network with
+/// `finish` or `finish_public`.
+///
+/// The total size is declared up front; finishing fails unless exactly that
+/// many bytes were written. The data is uploaded from a temporary file that is
+/// removed when the upload is finished or dropped.
+#[derive(uniffi::Object)]
+pub struct DataUpload {
+    client: Arc<autonomi::Client>,
+    size: u64,
+    spool: Mutex<Option<Spool>>,
+}
+
+impl DataUpload {
+    pub(crate) fn new(client: Arc<autonomi::Client>, size: u64) -> Result<Arc<Self>, ClientError> {
+        let path = std::env::temp_dir().join(format!(
+            "ant-ffi-upload-{}-{}",
+            std::process::id(),
+            NEXT_SPOOL.fetch_add(1, Ordering::Relaxed)
+        ));
+        let file = File::create(&path).map_err(|e| ClientError::NetworkError {
+            reason: format!("Failed to create upload spool: {}", e),
+        })?;
+
+        Ok(Arc::new(Self {
+            client,
+            size,
+            spool: Mutex::new(Some(Spool {
+                path,
+                file: Some(file),
+                written: 0,
+            })),
+        }))
+    }
+
+    /// Close the spool for writing and take it, checking the declared size
+    fn take_complete(&self) -> Result<Spool, ClientError> {
+        let mut guard = self.spool.lock().map_err(|e| ClientError::NetworkError {
+            reason: format!("Lock error: {}", e),
+        })?;
+        let mut spool = guard.take().ok_or_else(|| ClientError::InvalidInput {
+            reason: "Upload already finished".to_string(),
+        })?;
+
+        if spool.written != self.size {
+            return Err(ClientError::InvalidInput {
+                reason: format!("Expected {} bytes, got {}", self.size, spool.written),
+            });
+        }
+        if let Some(mut file) = spool.file.take() {
+            file.flush().map_err(|e| ClientError::NetworkError {
+                reason: format!("Failed to flush upload spool: {}", e),
+            })?;
+        }
+        Ok(spool)
+    }
+}
+
+#[uniffi::export(async_runtime = "tokio")]
+impl DataUpload {
+    /// Append the next piece of data
+    pub fn write(&self, data: Vec<u8>) -> Result<(), ClientError> {
+        let mut guard = self.spool.lock().map_err(|e| ClientError::NetworkError {
+            reason: format!("Lock error: {}", e),
+        })?;
+        let spool = guard.as_mut().ok_or_else(|| ClientError::InvalidInput {
+            reason: "Upload already finished".to_string(),
+        })?;
+
+        if spool.written + data.len() as u64 > self.size {
+            return Err(ClientError::InvalidInput {
+                reason: format!("Data exceeds the declared size of {} bytes", self.size),
+            });
+        }
+
+        let file = spool.file.as_mut().ok_or_else(|| ClientError::NetworkError {
+            reason: "Upload spool is closed".to_string(),
+        })?;
+        file.write_all(&data).map_err(|e| ClientError::NetworkError {
+            reason: format!("Failed to write upload spool: {}", e),
+        })?;
+        spool.written += data.len() as u64;
+        Ok(())
+    }
+
+    /// Number of bytes written so far
+    pub fn written(&self) -> u64 {
+        self.spool
+            .lock()
+            .ok()
+            .and_then(|guard| guard.as_ref().map(|spool| spool.written))
+            .unwrap_or(0)
+    }
+
+    /// Upload the data as private data, returning its data map
+    pub async fn finish(&self, payment: PaymentOption) -> Result<DataPutResult, ClientError> {
+        let spool = self.take_complete()?;
+        let autonomi_payment = match payment {
+            PaymentOption::WalletPayment { wallet_ref } => {
+                AutonomiPaymentOption::Wallet(wallet_ref.inner.clone())
+            }
+        };
+
+        let (cost, data_map) = self
+            .client
+            .file_content_upload(spool.path.clone(), autonomi_payment.into())
+            .await
+            .map_err(|e| ClientError::NetworkError {
+                reason: e.to_string(),
+            })?;
+
+        Ok(DataPutResult {
+            cost: cost.to_string(),
+            data_map: Arc::new(DataMapChunk { inner: data_map }),
+        })
+    }
+
+    /// Upload the data as public data, returning its address
+    pub async fn finish_public(&self, payment: PaymentOption) -> Result<UploadResult, ClientError> {
+        let spool = self.take_complete()?;
+        let autonomi_payment = match payment {
+            PaymentOption::WalletPayment { wallet_ref } => {
+                AutonomiPaymentOption::Wallet(wallet_ref.inner.clone())
+            }
+        };
+
+        let (price, address) = self
+            .client
+            .file_content_upload_public(spool.path.clone(), autonomi_payment.into())
+            .await
+            .map_err(|e| ClientError::NetworkError {
+                reason: e.to_string(),
+            })?;
+
+        Ok(UploadResult {
+            price: price.to_string(),
+            address: address.to_hex(),
+        })
+    }
+}
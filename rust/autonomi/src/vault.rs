@@ -6,19 +6,16 @@
 //! ## Current Implementation
 //! - ✅ VaultSecretKey: Secret key for vault encryption/decryption
 //! - ✅ UserData: Container for user's file archive references
+//! - ✅ UserData mutation methods: add, remove and rename public/private archive references
 //! - ✅ Client methods: vault_cost, vault_put, vault_get, vault_get_user_data, vault_put_user_data
-//!
-//! ## Missing APIs (available in Python bindings - Future Work)
-//! - ❌ UserData mutation methods:
-//!   - `add_file_archive(addr, name)` - Add public archive reference
-//!   - `add_private_file_archive(data_map, name)` - Add private archive reference
-//!   - `remove_file_archive(addr)` - Remove archive reference
 
 use autonomi::client::vault::{
     UserData as AutonomiUserData, VaultSecretKey as AutonomiVaultSecretKey,
 };
 use std::sync::Arc;
 
+use crate::archive::{ArchiveAddress, PrivateArchiveDataMap};
+
 /// Error type for vault operations
 #[derive(Debug, uniffi::Error, thiserror::Error)]
 pub enum VaultError {
@@ -26,6 +23,8 @@ pub enum VaultError {
     InvalidKey { reason: String },
     #[error("Parsing failed: {reason}")]
     ParsingFailed { reason: String },
+    #[error("Archive not found: {archive}")]
+    ArchiveNotFound { archive: String },
 }
 
 /// A secret key used to encrypt and decrypt vault data.
@@ -124,6 +123,76 @@ impl UserData {
             })
             .collect()
     }
+
+    /// Add a public archive reference with a name, replacing the name if already present
+    pub fn add_file_archive(&self, address: Arc<ArchiveAddress>, name: String) -> Arc<Self> {
+        let mut user_data = self.inner.clone();
+        user_data.file_archives.insert(address.inner, name);
+        Arc::new(Self { inner: user_data })
+    }
+
+    /// Add a private archive reference with a name, replacing the name if already present
+    pub fn add_private_file_archive(
+        &self,
+        data_map: Arc<PrivateArchiveDataMap>,
+        name: String,
+    ) -> Arc<Self> {
+        let mut user_data = self.inner.clone();
+        user_data
+            .private_file_archives
+            .insert(data_map.inner.clone(), name);
+        Arc::new(Self { inner: user_data })
+    }
+
+    /// Remove a public archive reference
+    pub fn remove_file_archive(&self, address: Arc<ArchiveAddress>) -> Arc<Self> {
+        let mut user_data = self.inner.clone();
+        user_data.file_archives.remove(&address.inner);
+        Arc::new(Self { inner: user_data })
+    }
+
+    /// Remove a private archive reference
+    pub fn remove_private_file_archive(&self, data_map: Arc<PrivateArchiveDataMap>) -> Arc<Self> {
+        let mut user_data = self.inner.clone();
+        user_data.private_file_archives.remove(&data_map.inner);
+        Arc::new(Self { inner: user_data })
+    }
+
+    /// Rename a public archive reference
+    pub fn rename_file_archive(
+        &self,
+        address: Arc<ArchiveAddress>,
+        name: String,
+    ) -> Result<Arc<Self>, VaultError> {
+        let mut user_data = self.inner.clone();
+        match user_data.file_archives.get_mut(&address.inner) {
+            Some(existing) => *existing = name,
+            None => {
+                return Err(VaultError::ArchiveNotFound {
+                    archive: address.inner.to_hex(),
+                })
+            }
+        }
+        Ok(Arc::new(Self { inner: user_data }))
+    }
+
+    /// Rename a private archive reference
+    pub fn rename_private_file_archive(
+        &self,
+        data_map: Arc<PrivateArchiveDataMap>,
+        name: String,
+    ) -> Result<Arc<Self>, VaultError> {
+        let mut user_data = self.inner.clone();
+        match user_data.private_file_archives.get_mut(&data_map.inner) {
+            Some(existing) => *existing = name,
+            None => {
+                return Err(VaultError::ArchiveNotFound {
+                    archive: data_map.inner.to_hex(),
+                })
+            }
+        }
+        Ok(Arc::new(Self { inner: user_data }))
+    }
 }
 
 /// Result of fetching vault data